@@ -0,0 +1,452 @@
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// CMSPage is the page object shared by the site pages and landing pages APIs
+type CMSPage struct {
+	Id                        string      `json:"id"`
+	Slug                      string      `json:"slug"`
+	ContentGroupId            string      `json:"contentGroupId"`
+	Campaign                  string      `json:"campaign"`
+	CategoryId                int64       `json:"categoryId"`
+	State                     string      `json:"state"`
+	Name                      string      `json:"name"`
+	MabExperimentId           string      `json:"mabExperimentId"`
+	Archived                  bool        `json:"archived"`
+	AuthorName                string      `json:"authorName"`
+	AbTestId                  string      `json:"abTestId"`
+	CreatedById               string      `json:"createdById"`
+	UpdatedById               string      `json:"updatedById"`
+	Domain                    string      `json:"domain"`
+	Subcategory               string      `json:"subcategory"`
+	AbStatus                  string      `json:"abStatus"`
+	FolderId                  string      `json:"folderId"`
+	WidgetContainers          interface{} `json:"widgetContainers"`
+	Widgets                   interface{} `json:"widgets"`
+	Language                  string      `json:"language"`
+	TranslatedFromId          string      `json:"translatedFromId"`
+	Translations              interface{} `json:"translations"`
+	DynamicPageDataSourceType int64       `json:"dynamicPageDataSourceType"`
+	DynamicPageDataSourceId   string      `json:"dynamicPageDataSourceId"`
+	DynamicPageHubDbTableId   string      `json:"dynamicPageHubDbTableId"`
+	HtmlTitle                 string      `json:"htmlTitle"`
+	PageRedirected            bool        `json:"pageRedirected"`
+	PageExpiryEnabled         bool        `json:"pageExpiryEnabled"`
+	PageExpiryRedirectId      int64       `json:"pageExpiryRedirectId"`
+	PageExpiryRedirectUrl     string      `json:"pageExpiryRedirectUrl"`
+	PageExpiryDate            int64       `json:"pageExpiryDate"`
+	IncludeDefaultCustomCss   bool        `json:"includeDefaultCustomCss"`
+	EnableLayoutStylesheets   bool        `json:"enableLayoutStylesheets"`
+	EnableDomainStylesheets   bool        `json:"enableDomainStylesheets"`
+	PublishImmediately        bool        `json:"publishImmediately"`
+	FeaturedImage             string      `json:"featuredImage"`
+	FeaturedImageAltText      string      `json:"featuredImageAltText"`
+	UseFeaturedImage          bool        `json:"useFeaturedImage"`
+	LinkRelCanonicalUrl       string      `json:"linkRelCanonicalUrl"`
+	ContentTypeCategory       json.Number `json:"contentTypeCategory"`
+	AttachedStylesheets       interface{} `json:"attachedStylesheets"`
+	MetaDescription           string      `json:"metaDescription"`
+	HeadHtml                  string      `json:"headHtml"`
+	FooterHtml                string      `json:"footerHtml"`
+	ArchivedInDashboard       bool        `json:"archivedInDashboard"`
+	PublicAccessRulesEnabled  bool        `json:"publicAccessRulesEnabled"`
+	PublicAccessRules         interface{} `json:"publicAccessRules"`
+	LayoutSections            interface{} `json:"layoutSections"`
+	ThemeSettingsValues       interface{} `json:"themeSettingsValues"`
+	TemplatePath              string      `json:"templatePath"`
+	Url                       string      `json:"url"`
+	Password                  string      `json:"password"`
+	CurrentState              string      `json:"currentState"`
+	CurrentlyPublished        bool        `json:"currentlyPublished"`
+	PublishDate               *time.Time  `json:"publishDate"`
+	Created                   *time.Time  `json:"created"`
+	Updated                   *time.Time  `json:"updated"`
+	ArchivedAt                *time.Time  `json:"archivedAt"`
+}
+
+type CMSPagesResponse struct {
+	Results []CMSPage      `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+// cmsPageColumns :: return the columns of the site page and landing page tables, described with the given page type, e.g. "site page"
+func cmsPageColumns(pageType string) []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The unique ID of the " + pageType + ".",
+			Transform:   transform.FromField("Id"),
+		},
+		{
+			Name:        "slug",
+			Type:        proto.ColumnType_STRING,
+			Description: "The path of the " + pageType + ". This field is appended to the domain to construct the url of the page.",
+		},
+		{
+			Name:        "content_group_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ID of the content group the " + pageType + " is associated with.",
+			Transform:   transform.FromField("ContentGroupId"),
+		},
+		{
+			Name:        "campaign",
+			Type:        proto.ColumnType_STRING,
+			Description: "The GUID of the marketing campaign the " + pageType + " is a part of.",
+		},
+		{
+			Name:        "category_id",
+			Type:        proto.ColumnType_INT,
+			Description: "ID of the category.",
+			Transform:   transform.FromField("CategoryId"),
+		},
+		{
+			Name:        "state",
+			Type:        proto.ColumnType_STRING,
+			Description: "An ENUM describing the current state of the " + pageType + ".",
+		},
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The internal name of the " + pageType + ".",
+		},
+		{
+			Name:        "mab_experiment_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ID of the MAB (Multi-Armed Bandit) experiment the " + pageType + " is associated with.",
+			Transform:   transform.FromField("MabExperimentId"),
+		},
+		{
+			Name:        "archived",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates whether the " + pageType + " is archived or not.",
+		},
+		{
+			Name:        "author_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the user that updated the " + pageType + ".",
+		},
+		{
+			Name:        "ab_test_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ID of the A/B test the " + pageType + " is associated with.",
+			Transform:   transform.FromField("AbTestId"),
+		},
+		{
+			Name:        "created_by_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ID of the user that created the " + pageType + ".",
+			Transform:   transform.FromField("CreatedById"),
+		},
+		{
+			Name:        "updated_by_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ID of the user that updated the " + pageType + ".",
+			Transform:   transform.FromField("UpdatedById"),
+		},
+		{
+			Name:        "domain",
+			Type:        proto.ColumnType_STRING,
+			Description: "The domain the " + pageType + " will resolve to. If null, the page will default to the primary domain for its content type.",
+		},
+		{
+			Name:        "subcategory",
+			Type:        proto.ColumnType_STRING,
+			Description: "The subcategory of the " + pageType + ".",
+		},
+		{
+			Name:        "ab_status",
+			Type:        proto.ColumnType_STRING,
+			Description: "The AB status.",
+		},
+		{
+			Name:        "folder_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The folder ID.",
+			Transform:   transform.FromField("FolderId"),
+		},
+		{
+			Name:        "widget_containers",
+			Type:        proto.ColumnType_JSON,
+			Description: "A data structure containing the data for all the modules inside the containers for the " + pageType + ". This will only be populated if the page has widget containers.",
+		},
+		{
+			Name:        "widgets",
+			Type:        proto.ColumnType_JSON,
+			Description: "A data structure containing the data for all the modules for the " + pageType + ".",
+		},
+		{
+			Name:        "language",
+			Type:        proto.ColumnType_STRING,
+			Description: "The explicitly defined ISO 639 language code of the " + pageType + ".",
+		},
+		{
+			Name:        "translated_from_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "ID of the primary " + pageType + " this object was translated from.",
+			Transform:   transform.FromField("TranslatedFromId"),
+		},
+		{
+			Name:        "translations",
+			Type:        proto.ColumnType_JSON,
+			Description: "Map of translations for the " + pageType + ".",
+		},
+		{
+			Name:        "dynamic_page_data_source_type",
+			Type:        proto.ColumnType_INT,
+			Description: "The type of dynamic data source for the page.",
+		},
+		{
+			Name:        "dynamic_page_data_source_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ID of the dynamic data source for the page.",
+			Transform:   transform.FromField("DynamicPageDataSourceId"),
+		},
+		{
+			Name:        "dynamic_page_hub_db_table_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ID of the HubDB table the dynamic page is built from.",
+			Transform:   transform.FromField("DynamicPageHubDbTableId"),
+		},
+		{
+			Name:        "html_title",
+			Type:        proto.ColumnType_STRING,
+			Description: "The HTML title of the " + pageType + ".",
+		},
+		{
+			Name:        "page_redirected",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates whether the " + pageType + " is redirected or not.",
+		},
+		{
+			Name:        "currently_published",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates whether the " + pageType + " is currently published or not.",
+		},
+		{
+			Name:        "page_expiry_enabled",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates whether the page expiry is enabled or not.",
+		},
+		{
+			Name:        "page_expiry_redirect_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the page to redirect to upon expiry.",
+			Transform:   transform.FromField("PageExpiryRedirectId"),
+		},
+		{
+			Name:        "page_expiry_redirect_url",
+			Type:        proto.ColumnType_STRING,
+			Description: "The URL to redirect to upon expiry.",
+		},
+		{
+			Name:        "page_expiry_date",
+			Type:        proto.ColumnType_INT,
+			Description: "The expiry date of the page.",
+		},
+		{
+			Name:        "include_default_custom_css",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Boolean to determine whether or not to apply the Primary CSS Files.",
+		},
+		{
+			Name:        "enable_layout_stylesheets",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Boolean to determine whether or not to apply the styles from the template.",
+		},
+		{
+			Name:        "enable_domain_stylesheets",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Boolean to determine whether or not to apply the styles from the domain.",
+		},
+		{
+			Name:        "publish_immediately",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Set this to true if you want to be published immediately when the schedule publish endpoint is called, and to ignore the publish_date setting.",
+		},
+		{
+			Name:        "featured_image",
+			Type:        proto.ColumnType_STRING,
+			Description: "The featured image of the " + pageType + ".",
+		},
+		{
+			Name:        "featured_image_alt_text",
+			Type:        proto.ColumnType_STRING,
+			Description: "The alt text of the featured image.",
+		},
+		{
+			Name:        "use_featured_image",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Boolean to determine if the " + pageType + " should use a featured image.",
+		},
+		{
+			Name:        "link_rel_canonical_url",
+			Type:        proto.ColumnType_STRING,
+			Description: "Optional override to set the URL to be used in the rel=canonical link tag on the page.",
+		},
+		{
+			Name:        "content_type_category",
+			Type:        proto.ColumnType_INT,
+			Description: "An ENUM describing the type of this object.",
+			Transform:   transform.FromField("ContentTypeCategory").Transform(transform.ToString).NullIfZero(),
+		},
+		{
+			Name:        "attached_stylesheets",
+			Type:        proto.ColumnType_JSON,
+			Description: "List of stylesheets to attach to the " + pageType + ". These stylesheets are attached to just this page.",
+		},
+		{
+			Name:        "meta_description",
+			Type:        proto.ColumnType_STRING,
+			Description: "A description that goes in the <meta> tag on the page.",
+		},
+		{
+			Name:        "head_html",
+			Type:        proto.ColumnType_STRING,
+			Description: "Custom HTML for embed codes, javascript, etc. that goes in the <head> tag of the page.",
+		},
+		{
+			Name:        "footer_html",
+			Type:        proto.ColumnType_STRING,
+			Description: "Custom HTML for embed codes, javascript that should be placed before the </body> tag of the page.",
+		},
+		{
+			Name:        "archived_in_dashboard",
+			Type:        proto.ColumnType_BOOL,
+			Description: "If true, the page will not show up in your dashboard, although the page could still be live.",
+		},
+		{
+			Name:        "public_access_rules_enabled",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Boolean to determine whether or not to respect public access rules.",
+		},
+		{
+			Name:        "public_access_rules",
+			Type:        proto.ColumnType_JSON,
+			Description: "Rules for requiring member registration to access private content.",
+		},
+		{
+			Name:        "layout_sections",
+			Type:        proto.ColumnType_JSON,
+			Description: "Map of layout sections for the " + pageType + ".",
+		},
+		{
+			Name:        "theme_settings_values",
+			Type:        proto.ColumnType_JSON,
+			Description: "Map of theme settings values for the " + pageType + ".",
+		},
+		{
+			Name:        "template_path",
+			Type:        proto.ColumnType_STRING,
+			Description: "The path of the template used by the " + pageType + ".",
+		},
+		{
+			Name:        "url",
+			Type:        proto.ColumnType_STRING,
+			Description: "The generated URL of the " + pageType + ".",
+		},
+		{
+			Name:        "password",
+			Type:        proto.ColumnType_STRING,
+			Description: "Set this to create a password-protected page. Entering the password will be required to view the page.",
+		},
+		{
+			Name:        "current_state",
+			Type:        proto.ColumnType_STRING,
+			Description: "A generated ENUM describing the current state of the " + pageType + ". Should always match the 'state' field.",
+		},
+		{
+			Name:        "publish_date",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "The date and time the " + pageType + " is scheduled to be published.",
+		},
+		{
+			Name:        "created",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "The timestamp when the " + pageType + " was created.",
+		},
+		{
+			Name:        "updated",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "The timestamp when the " + pageType + " was last updated.",
+		},
+		{
+			Name:        "archived_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "The timestamp when the " + pageType + " was archived.",
+		},
+
+		/// Steampipe standard columns
+		{
+			Name:        "title",
+			Description: "Title of the resource.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Name"),
+		},
+	}
+}
+
+// listCMSPages :: stream the pages returned by the given pages API path, e.g. /cms/v3/pages/site-pages
+func listCMSPages(ctx context.Context, d *plugin.QueryData, path string) error {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	archived := false
+
+	if d.EqualsQuals["archived"] != nil {
+		archived = d.EqualsQuals["archived"].GetBoolValue()
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	params.Set("archived", strconv.FormatBool(archived))
+
+	for {
+		var response CMSPagesResponse
+		err := getHubSpotApiResponse(ctx, d, path, params, &response)
+		if err != nil {
+			return err
+		}
+		for _, page := range response.Results {
+			d.StreamListItem(ctx, page)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil
+}
+
+// getCMSPage :: return the page with the given ID from the given pages API path
+func getCMSPage(ctx context.Context, d *plugin.QueryData, path string, id string) (*CMSPage, error) {
+	var page CMSPage
+	err := getHubSpotApiResponse(ctx, d, path+"/"+url.PathEscape(id), nil, &page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
@@ -3,8 +3,10 @@ package hubspot
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
@@ -30,17 +32,24 @@ var getPortalIdMemoized = plugin.HydrateFunc(getPortalInfoUncached).Memoize(memo
 // declare a wrapper hydrate function to call the memoized function
 // - this is required when a memoized function is used for a column definition
 func getPortalId(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Rows carry the ID of the portal they were listed from in their matrix item
+	if portalId, _ := plugin.GetMatrixItem(ctx)[matrixKeyPortalId].(string); portalId != "" {
+		return portalId, nil
+	}
+
 	acc, err := getPortalIdMemoized(ctx, d, h)
 	if err != nil {
 		return nil, err
 	}
 
-	return acc.(AccountInfo).PortalID, nil
+	// The portal ID is returned as a string to match the type of the portal_id column, so that
+	// quals such as portal_id = '123' can be compared against the portal matrix items
+	return strconv.FormatInt(acc.(AccountInfo).PortalID, 10), nil
 }
 
 // Build a cache key for the call to getPortalIdCacheKey.
 func getPortalIdCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	key := "getPortalId"
+	key := "getPortalId-" + getPortalName(ctx)
 	return key, nil
 }
 
@@ -81,6 +90,12 @@ func getPortalInfoUncached(ctx context.Context, d *plugin.QueryData, h *plugin.H
 		return nil, err
 	}
 
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("%s %s: %s", resp.Status, url, string(responseBody))
+		plugin.Logger(ctx).Error("getPortalIdUncached", "api_error", err)
+		return nil, err
+	}
+
 	var accInfo AccountInfo
 	if err := json.Unmarshal(responseBody, &accInfo); err != nil {
 		plugin.Logger(ctx).Error("getPortalIdUncached", "Error unmarshalling JSON", err)
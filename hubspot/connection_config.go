@@ -5,7 +5,12 @@ import (
 )
 
 type hubSpotConfig struct {
-	PrivateAppToken *string `hcl:"private_app_token"`
+	PrivateAppToken      *string           `hcl:"private_app_token"`
+	AppId                *int              `hcl:"app_id"`
+	DeveloperApiKey      *string           `hcl:"developer_api_key"`
+	Portals              map[string]string `hcl:"portals,optional"`
+	PortalTokenEnvVars   []string          `hcl:"portal_token_env_vars,optional"`
+	MaxPortalConcurrency *int              `hcl:"max_portal_concurrency"`
 }
 
 func ConfigInstance() interface{} {
@@ -20,12 +20,6 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		DefaultRetryConfig: &plugin.RetryConfig{
 			ShouldRetryErrorFunc: shouldRetryError([]string{"429"}),
 		},
-		ConnectionKeyColumns: []plugin.ConnectionKeyColumn{
-			{
-				Name:    "portal_id",
-				Hydrate: getPortalId,
-			},
-		},
 		SchemaMode:   plugin.SchemaModeStatic,
 		TableMapFunc: pluginTableDefinitions,
 	}
@@ -35,6 +29,12 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 
 func pluginTableDefinitions(ctx context.Context, d *plugin.TableMapData) (map[string]*plugin.Table, error) {
 
+	// Validate the portals when the connection config is loaded, so that a misconfigured connection fails with the config error
+	if _, err := getConfiguredPortals(GetConfig(d.Connection)); err != nil {
+		plugin.Logger(ctx).Error("pluginTableDefinitions", "config_error", err)
+		return nil, err
+	}
+
 	// set Connection and ConnectionCache
 	queryData := &plugin.QueryData{
 		Connection:      d.Connection,
@@ -71,14 +71,55 @@ func pluginTableDefinitions(ctx context.Context, d *plugin.TableMapData) (map[st
 
 	// Initialize tables
 	tables := map[string]*plugin.Table{
-		"hubspot_blog_post": tableHubSpotBlogPost(ctx),
-		"hubspot_company":   tableHubSpotCompany(ctx, companyPropertiesColumns),
-		"hubspot_contact":   tableHubSpotContact(ctx, contactPropertiesColumns),
-		"hubspot_deal":      tableHubSpotDeal(ctx, dealPropertiesColumns),
-		"hubspot_domain":    tableHubSpotDomain(ctx),
-		"hubspot_hub_db":    tableHubSpotHubDB(ctx),
-		"hubspot_owner":     tableHubSpotOwner(ctx),
-		"hubspot_ticket":    tableHubSpotTicket(ctx, ticketPropertiesColumns),
+		"hubspot_audit_log":               tableHubSpotAuditLog(ctx),
+		"hubspot_blog":                    tableHubSpotBlog(ctx),
+		"hubspot_blog_author":             tableHubSpotBlogAuthor(ctx),
+		"hubspot_blog_post":               tableHubSpotBlogPost(ctx),
+		"hubspot_blog_post_revision":      tableHubSpotBlogPostRevision(ctx),
+		"hubspot_blog_tag":                tableHubSpotBlogTag(ctx),
+		"hubspot_campaign":                tableHubSpotCampaign(ctx),
+		"hubspot_cms_source_file":         tableHubSpotCMSSourceFile(ctx),
+		"hubspot_company":                 tableHubSpotCompany(ctx, companyPropertiesColumns),
+		"hubspot_contact":                 tableHubSpotContact(ctx, contactPropertiesColumns),
+		"hubspot_conversation_inbox":      tableHubSpotConversationInbox(ctx),
+		"hubspot_conversation_message":    tableHubSpotConversationMessage(ctx),
+		"hubspot_conversation_thread":     tableHubSpotConversationThread(ctx),
+		"hubspot_deal":                    tableHubSpotDeal(ctx, dealPropertiesColumns),
+		"hubspot_domain":                  tableHubSpotDomain(ctx),
+		"hubspot_export":                  tableHubSpotExport(ctx),
+		"hubspot_file":                    tableHubSpotFile(ctx),
+		"hubspot_file_folder":             tableHubSpotFileFolder(ctx),
+		"hubspot_form":                    tableHubSpotForm(ctx),
+		"hubspot_form_submission":         tableHubSpotFormSubmission(ctx),
+		"hubspot_hub_db":                  tableHubSpotHubDB(ctx),
+		"hubspot_hub_db_column":           tableHubSpotHubDBColumn(ctx),
+		"hubspot_hub_db_row":              tableHubSpotHubDBRow(ctx),
+		"hubspot_import":                  tableHubSpotImport(ctx),
+		"hubspot_import_error":            tableHubSpotImportError(ctx),
+		"hubspot_knowledge_article":       tableHubSpotKnowledgeArticle(ctx),
+		"hubspot_landing_page":            tableHubSpotLandingPage(ctx),
+		"hubspot_login_history":           tableHubSpotLoginHistory(ctx),
+		"hubspot_marketing_email":         tableHubSpotMarketingEmail(ctx),
+		"hubspot_owner":                   tableHubSpotOwner(ctx),
+		"hubspot_owner_team":              tableHubSpotOwnerTeam(ctx),
+		"hubspot_role":                    tableHubSpotRole(ctx),
+		"hubspot_security_activity":       tableHubSpotSecurityActivity(ctx),
+		"hubspot_site_page":               tableHubSpotSitePage(ctx),
+		"hubspot_subscription_status":     tableHubSpotSubscriptionStatus(ctx),
+		"hubspot_subscription_type":       tableHubSpotSubscriptionType(ctx),
+		"hubspot_team":                    tableHubSpotTeam(ctx),
+		"hubspot_ticket":                  tableHubSpotTicket(ctx, ticketPropertiesColumns),
+		"hubspot_timeline_event_template": tableHubSpotTimelineEventTemplate(ctx),
+		"hubspot_url_redirect":            tableHubSpotUrlRedirect(ctx),
+		"hubspot_user":                    tableHubSpotUser(ctx),
+		"hubspot_webhook_settings":        tableHubSpotWebhookSettings(ctx),
+		"hubspot_webhook_subscription":    tableHubSpotWebhookSubscription(ctx),
+		"hubspot_workflow":                tableHubSpotWorkflow(ctx),
+	}
+
+	// Fan out the tables of a portal across the portals of the connection
+	for _, table := range tables {
+		addPortalMatrix(table)
 	}
 
 	return tables, nil
@@ -0,0 +1,194 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+)
+
+const (
+	matrixKeyPortalName = "portal_name"
+	matrixKeyPortalId   = "portal_id"
+
+	defaultMaxPortalConcurrency = 10
+)
+
+type hubSpotPortal struct {
+	Name  string
+	Token string
+}
+
+// getConfiguredPortals :: return the portals configured with the portals and portal_token_env_vars arguments, sorted by name
+func getConfiguredPortals(config hubSpotConfig) ([]hubSpotPortal, error) {
+	portals := []hubSpotPortal{}
+	for name, token := range config.Portals {
+		if token == "" {
+			return nil, fmt.Errorf("the token of the portal '%s' in 'portals' is empty", name)
+		}
+		portals = append(portals, hubSpotPortal{Name: name, Token: token})
+	}
+
+	// Portals configured with an environment variable are named after the variable
+	for _, envVar := range config.PortalTokenEnvVars {
+		token := os.Getenv(envVar)
+		if token == "" {
+			return nil, fmt.Errorf("the environment variable '%s' listed in 'portal_token_env_vars' is not set", envVar)
+		}
+		if _, ok := config.Portals[envVar]; ok {
+			return nil, fmt.Errorf("the portal '%s' is configured in both 'portals' and 'portal_token_env_vars'", envVar)
+		}
+		portals = append(portals, hubSpotPortal{Name: envVar, Token: token})
+	}
+
+	sort.Slice(portals, func(i, j int) bool {
+		return portals[i].Name < portals[j].Name
+	})
+
+	return portals, nil
+}
+
+// getPortalName :: return the name of the portal a list, get or hydrate call is made for, or an empty string for the default portal
+func getPortalName(ctx context.Context) string {
+	name, _ := plugin.GetMatrixItem(ctx)[matrixKeyPortalName].(string)
+	return name
+}
+
+// portalMatrix :: fan out the queries of a connection across its portals.
+// Each matrix item carries the portal ID, so that quals such as portal_id = '123' skip the other portals without calling their list APIs.
+// This also prunes the connections of an aggregator, as each connection only resolves the IDs of its own portals.
+func portalMatrix(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+	config := GetConfig(d.Connection)
+	portals, err := getConfiguredPortals(config)
+	if err != nil {
+		// The config is validated when the connection is loaded, but the matrix func cannot return an error.
+		// Keep the default portal, so that connect returns the config error to the list and get calls.
+		plugin.Logger(ctx).Error("portalMatrix", "config_error", err)
+		return []map[string]interface{}{{matrixKeyPortalName: "", matrixKeyPortalId: ""}}
+	}
+
+	// Without multiple portals, the connection queries the single portal of the private_app_token
+	names := []string{""}
+	if len(portals) > 0 {
+		names = make([]string, len(portals))
+		for i, portal := range portals {
+			names[i] = portal.Name
+		}
+	}
+
+	matrix := make([]map[string]interface{}, len(names))
+	semaphore := make(chan struct{}, getMaxPortalConcurrency(config))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			item := map[string]interface{}{
+				matrixKeyPortalName: name,
+				matrixKeyPortalId:   "",
+			}
+			portalId, err := getPortalId(context.WithValue(ctx, context_key.MatrixItem, item), d, nil)
+			if err != nil {
+				// Keep the portal, so that the error is returned by its list and get calls rather than silently dropping its rows
+				plugin.Logger(ctx).Error("portalMatrix", "portal_name", name, "api_error", err)
+			} else {
+				item[matrixKeyPortalId] = portalId
+			}
+			matrix[i] = item
+		}(i, name)
+	}
+	wg.Wait()
+
+	return matrix
+}
+
+func getMaxPortalConcurrency(config hubSpotConfig) int {
+	if config.MaxPortalConcurrency != nil && *config.MaxPortalConcurrency > 0 {
+		return *config.MaxPortalConcurrency
+	}
+	return defaultMaxPortalConcurrency
+}
+
+// The list calls of a scan share a semaphore, keyed by the query context shared by all the matrix items of the scan.
+// A connection wide semaphore could deadlock joins, as the list calls of the outer table hold it while waiting for rows to be consumed.
+type portalLimiter struct {
+	semaphore chan struct{}
+	users     int
+}
+
+var (
+	portalLimiters     = map[*plugin.QueryContext]*portalLimiter{}
+	portalLimitersLock sync.Mutex
+)
+
+func acquirePortalLimiter(d *plugin.QueryData) *portalLimiter {
+	portalLimitersLock.Lock()
+	defer portalLimitersLock.Unlock()
+
+	limiter, ok := portalLimiters[d.QueryContext]
+	if !ok {
+		limiter = &portalLimiter{semaphore: make(chan struct{}, getMaxPortalConcurrency(GetConfig(d.Connection)))}
+		portalLimiters[d.QueryContext] = limiter
+	}
+	limiter.users++
+
+	return limiter
+}
+
+func releasePortalLimiter(d *plugin.QueryData, limiter *portalLimiter) {
+	portalLimitersLock.Lock()
+	defer portalLimitersLock.Unlock()
+
+	limiter.users--
+	if limiter.users == 0 {
+		delete(portalLimiters, d.QueryContext)
+	}
+}
+
+// limitPortalConcurrency :: bound the number of portals a scan lists concurrently
+func limitPortalConcurrency(listFunc plugin.HydrateFunc) plugin.HydrateFunc {
+	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+		limiter := acquirePortalLimiter(d)
+		defer releasePortalLimiter(d, limiter)
+
+		select {
+		case limiter.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		defer func() { <-limiter.semaphore }()
+
+		return listFunc(ctx, d, h)
+	}
+}
+
+// addPortalMatrix :: fan out the list and get calls of the tables with a portal_id column across the portals of the connection
+func addPortalMatrix(table *plugin.Table) {
+	hasPortalId := false
+	for _, column := range table.Columns {
+		if column.Name == matrixKeyPortalId {
+			hasPortalId = true
+			break
+		}
+	}
+	if !hasPortalId {
+		return
+	}
+
+	table.GetMatrixItemFunc = portalMatrix
+	if table.List == nil {
+		return
+	}
+	if table.List.ParentHydrate != nil {
+		table.List.ParentHydrate = limitPortalConcurrency(table.List.ParentHydrate)
+	} else {
+		table.List.Hydrate = limitPortalConcurrency(table.List.Hydrate)
+	}
+}
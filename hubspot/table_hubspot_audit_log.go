@@ -0,0 +1,169 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotAuditLog(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_audit_log",
+		Description: "List of HubSpot account Audit Logs.",
+		List: &plugin.ListConfig{
+			Hydrate: listAuditLogs,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:      "occurred_at",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
+				{
+					Name:    "acting_user_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the audit log entry.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "category",
+				Type:        proto.ColumnType_STRING,
+				Description: "The category of the action, e.g. LOGIN, USER_MANAGEMENT, PROPERTY.",
+			},
+			{
+				Name:        "sub_category",
+				Type:        proto.ColumnType_STRING,
+				Description: "The sub category of the action.",
+			},
+			{
+				Name:        "action",
+				Type:        proto.ColumnType_STRING,
+				Description: "The action which was performed, e.g. CREATE, UPDATE, DELETE.",
+			},
+			{
+				Name:        "target_object_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the object the action was performed on.",
+				Transform:   transform.FromField("TargetObjectId"),
+			},
+			{
+				Name:        "target_object_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the object the action was performed on.",
+				Transform:   transform.FromField("TargetObjectType"),
+			},
+			{
+				Name:        "acting_user_id",
+				Type:        proto.ColumnType_INT,
+				Description: "The ID of the user who performed the action.",
+				Transform:   transform.FromField("ActingUser.UserId"),
+			},
+			{
+				Name:        "acting_user_email",
+				Type:        proto.ColumnType_STRING,
+				Description: "The email address of the user who performed the action.",
+				Transform:   transform.FromField("ActingUser.UserEmail"),
+			},
+			{
+				Name:        "occurred_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the action was performed.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type AuditLog struct {
+	Id               string      `json:"id"`
+	Category         string      `json:"category"`
+	SubCategory      string      `json:"subCategory"`
+	Action           string      `json:"action"`
+	TargetObjectId   string      `json:"targetObjectId"`
+	TargetObjectType string      `json:"targetObjectType"`
+	ActingUser       *ActingUser `json:"actingUser"`
+	OccurredAt       *time.Time  `json:"occurredAt"`
+}
+
+type ActingUser struct {
+	UserId    string `json:"userId"`
+	UserEmail string `json:"userEmail"`
+}
+
+type AuditLogsResponse struct {
+	Results []AuditLog     `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listAuditLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	if d.EqualsQuals["acting_user_id"] != nil {
+		params.Set("userId", strconv.FormatInt(d.EqualsQuals["acting_user_id"].GetInt64Value(), 10))
+	}
+
+	// The API filters on the time range in which the actions occurred.
+	// The bounds are widened by a millisecond, so that the actions on a >= or <= boundary are returned whether or not the API bounds are inclusive.
+	start, end := getTimeRangeFromQuals(d, "occurred_at")
+	if start != nil {
+		params.Set("occurredAfter", start.Add(-time.Millisecond).Format(time.RFC3339Nano))
+	}
+	if end != nil {
+		params.Set("occurredBefore", end.Add(time.Millisecond).Format(time.RFC3339Nano))
+	}
+
+	for {
+		var response AuditLogsResponse
+		err := getHubSpotApiResponse(ctx, d, "/account-info/v3/activity/audit-logs", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_audit_log.listAuditLogs", "api_error", err)
+			return nil, err
+		}
+		for _, auditLog := range response.Results {
+			d.StreamListItem(ctx, auditLog)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,198 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotBlog(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_blog",
+		Description: "List of HubSpot Blogs.",
+		List: &plugin.ListConfig{
+			Hydrate: listBlogs,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getBlog,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the Blog. Blog posts reference it as content_group_id.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The internal name of the Blog.",
+			},
+			{
+				Name:        "public_title",
+				Type:        proto.ColumnType_STRING,
+				Description: "The public facing title of the Blog.",
+			},
+			{
+				Name:        "html_title",
+				Type:        proto.ColumnType_STRING,
+				Description: "The HTML title of the Blog listing page.",
+			},
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "The description of the Blog.",
+			},
+			{
+				Name:        "slug",
+				Type:        proto.ColumnType_STRING,
+				Description: "The path of the Blog.",
+			},
+			{
+				Name:        "absolute_url",
+				Type:        proto.ColumnType_STRING,
+				Description: "The full URL of the Blog listing page.",
+			},
+			{
+				Name:        "language",
+				Type:        proto.ColumnType_STRING,
+				Description: "The explicitly defined ISO 639 language code of the Blog.",
+			},
+			{
+				Name:        "translated_from_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "ID of the primary Blog this object was translated from.",
+				Transform:   transform.FromField("TranslatedFromId"),
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the Blog is archived.",
+				Transform:   transform.FromField("DeletedAt").Transform(archivedFromDeletedAt),
+			},
+			{
+				Name:        "created",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the Blog was created.",
+			},
+			{
+				Name:        "updated",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the Blog was last updated.",
+			},
+			{
+				Name:        "deleted_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the Blog was deleted.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type Blog struct {
+	Id               string     `json:"id"`
+	Name             string     `json:"name"`
+	PublicTitle      string     `json:"publicTitle"`
+	HtmlTitle        string     `json:"htmlTitle"`
+	Description      string     `json:"description"`
+	Slug             string     `json:"slug"`
+	AbsoluteUrl      string     `json:"absoluteUrl"`
+	Language         string     `json:"language"`
+	TranslatedFromId string     `json:"translatedFromId"`
+	Created          *time.Time `json:"created"`
+	Updated          *time.Time `json:"updated"`
+	DeletedAt        *time.Time `json:"deletedAt"`
+}
+
+type BlogsResponse struct {
+	Results []Blog         `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listBlogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	archived := false
+
+	if d.EqualsQuals["archived"] != nil {
+		archived = d.EqualsQuals["archived"].GetBoolValue()
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	params.Set("archived", strconv.FormatBool(archived))
+
+	for {
+		var response BlogsResponse
+		err := getHubSpotApiResponse(ctx, d, "/cms/v3/blog-settings/settings", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_blog.listBlogs", "api_error", err)
+			return nil, err
+		}
+		for _, blog := range response.Results {
+			d.StreamListItem(ctx, blog)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBlog(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	var blog Blog
+	err := getHubSpotApiResponse(ctx, d, "/cms/v3/blog-settings/settings/"+url.PathEscape(id), nil, &blog)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_blog.getBlog", "api_error", err)
+		return nil, err
+	}
+
+	return blog, nil
+}
@@ -0,0 +1,232 @@
+package hubspot
+
+import (
+	"context"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/authors"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotBlogAuthor(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_blog_author",
+		Description: "List of HubSpot Blog Authors.",
+		List: &plugin.ListConfig{
+			Hydrate: listBlogAuthors,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getBlogAuthor,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the Blog Author.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "full_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The full name of the Blog Author.",
+			},
+			{
+				Name:        "display_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The full name of the Blog Author to be displayed.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the Blog Author.",
+			},
+			{
+				Name:        "email",
+				Type:        proto.ColumnType_STRING,
+				Description: "Email address of the Blog Author.",
+			},
+			{
+				Name:        "slug",
+				Type:        proto.ColumnType_STRING,
+				Description: "The path of the Blog Author page.",
+			},
+			{
+				Name:        "language",
+				Type:        proto.ColumnType_STRING,
+				Description: "The explicitly defined ISO 639 language code of the Blog Author.",
+			},
+			{
+				Name:        "translated_from_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "ID of the primary Blog Author this object was translated from.",
+				Transform:   transform.FromField("TranslatedFromId"),
+			},
+			{
+				Name:        "bio",
+				Type:        proto.ColumnType_STRING,
+				Description: "A short biography of the Blog Author.",
+			},
+			{
+				Name:        "website",
+				Type:        proto.ColumnType_STRING,
+				Description: "URL to the website of the Blog Author.",
+			},
+			{
+				Name:        "twitter",
+				Type:        proto.ColumnType_STRING,
+				Description: "URL or username of the Twitter account associated with the Blog Author.",
+			},
+			{
+				Name:        "facebook",
+				Type:        proto.ColumnType_STRING,
+				Description: "URL to the Facebook page of the Blog Author.",
+			},
+			{
+				Name:        "linkedin",
+				Type:        proto.ColumnType_STRING,
+				Description: "URL to the LinkedIn page of the Blog Author.",
+			},
+			{
+				Name:        "avatar",
+				Type:        proto.ColumnType_STRING,
+				Description: "URL to the avatar of the Blog Author, if supplying a custom one.",
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the Blog Author is archived.",
+				Transform:   transform.FromField("DeletedAt").Transform(archivedFromDeletedAt),
+			},
+			{
+				Name:        "created",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the Blog Author was created.",
+			},
+			{
+				Name:        "updated",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the Blog Author was last updated.",
+			},
+			{
+				Name:        "deleted_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the Blog Author was deleted.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBlogAuthors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_blog_author.listBlogAuthors", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := authors.NewAPIClient(authors.NewConfiguration())
+
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	var after string = ""
+	archived := false
+
+	if d.EqualsQuals["archived"] != nil {
+		archived = d.EqualsQuals["archived"].GetBoolValue()
+	}
+
+	for {
+		if after == "" {
+			response, _, err := client.BlogAuthorsApi.GetPage(context).Limit(maxLimit).Archived(archived).Execute()
+			if err != nil {
+				plugin.Logger(ctx).Error("hubspot_blog_author.listBlogAuthors", "api_error", err)
+				return nil, err
+			}
+			for _, blogAuthor := range response.Results {
+				d.StreamListItem(ctx, blogAuthor)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+			if !response.Paging.HasNext() {
+				break
+			}
+			after = response.Paging.Next.After
+		} else {
+			response, _, err := client.BlogAuthorsApi.GetPage(context).Limit(maxLimit).After(after).Archived(archived).Execute()
+			if err != nil {
+				plugin.Logger(ctx).Error("hubspot_blog_author.listBlogAuthors", "api_error", err)
+				return nil, err
+			}
+			for _, blogAuthor := range response.Results {
+				d.StreamListItem(ctx, blogAuthor)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+			if !response.Paging.HasNext() {
+				break
+			}
+			after = response.Paging.Next.After
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBlogAuthor(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_blog_author.getBlogAuthor", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := authors.NewAPIClient(authors.NewConfiguration())
+
+	blogAuthor, _, err := client.BlogAuthorsApi.GetByID(context, id).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_blog_author.getBlogAuthor", "api_error", err)
+		return nil, err
+	}
+
+	return *blogAuthor, nil
+}
@@ -2,6 +2,9 @@ package hubspot
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strconv"
 
 	hubspot "github.com/clarkmcc/go-hubspot"
 	"github.com/clarkmcc/go-hubspot/generated/v3/blog_posts"
@@ -10,12 +13,34 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
+const (
+	blogPostVersionPublished = "published"
+	blogPostVersionDraft     = "draft"
+)
+
+// blogPostPropertyFilters :: the columns which are passed to the listing API as property filters
+var blogPostPropertyFilters = map[string]string{
+	"state":            "state",
+	"slug":             "slug",
+	"content_group_id": "contentGroupId",
+	"language":         "language",
+}
+
+// blogPostTimeOperators :: the listing API filter suffixes for the operators supported on timestamp columns
+var blogPostTimeOperators = map[string]string{
+	"=":  "eq",
+	">":  "gt",
+	">=": "gte",
+	"<":  "lt",
+	"<=": "lte",
+}
+
 //// TABLE DEFINITION
 
 func tableHubSpotBlogPost(ctx context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "hubspot_blog_post",
-		Description: "List of HubSpot BlogPosts.",
+		Description: "List of HubSpot published or draft BlogPosts.",
 		List: &plugin.ListConfig{
 			Hydrate: listBlogPosts,
 			KeyColumns: []*plugin.KeyColumn{
@@ -23,11 +48,55 @@ func tableHubSpotBlogPost(ctx context.Context) *plugin.Table {
 					Name:    "archived",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "version",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "created",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<=", "="},
+				},
+				{
+					Name:      "updated",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<=", "="},
+				},
+				{
+					Name:      "publish_date",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<=", "="},
+				},
+				{
+					Name:    "state",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "slug",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "content_group_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "language",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
-			Hydrate:    getBlogPost,
-			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate: getBlogPost,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "version",
+					Require: plugin.Optional,
+				},
+			},
 		},
 		Columns: commonColumns([]*plugin.Column{
 			{
@@ -348,6 +417,13 @@ func tableHubSpotBlogPost(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Description: "The timestamp when this Blog Post was deleted.",
 			},
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "The version of the Blog Post, either published or draft. Defaults to published.",
+				Transform:   transform.FromQual("version"),
+				Default:     blogPostVersionPublished,
+			},
 
 			/// Steampipe standard columns
 			{
@@ -360,16 +436,18 @@ func tableHubSpotBlogPost(ctx context.Context) *plugin.Table {
 	}
 }
 
+type BlogPostsResponse struct {
+	Results []blog_posts.BlogPost `json:"results"`
+	Paging  *hubSpotPaging        `json:"paging"`
+}
+
 //// LIST FUNCTION
 
 func listBlogPosts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	authorizer, err := connect(ctx, d)
+	version, err := getBlogPostVersion(d)
 	if err != nil {
-		plugin.Logger(ctx).Error("hubspot_blog_post.listBlogPosts", "connection_error", err)
 		return nil, err
 	}
-	context := hubspot.WithAuthorizer(context.Background(), authorizer)
-	client := blog_posts.NewAPIClient(blog_posts.NewConfiguration())
 
 	// Limiting the results
 	var maxLimit int32 = 100
@@ -379,56 +457,89 @@ func listBlogPosts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 			maxLimit = limit
 		}
 	}
-	var after string = ""
 	archived := false
 
 	if d.EqualsQuals["archived"] != nil {
 		archived = d.EqualsQuals["archived"].GetBoolValue()
 	}
 
-	for {
-		if after == "" {
-			response, _, err := client.BlogPostsApi.GetPage(context).Limit(maxLimit).Archived(archived).Execute()
-			if err != nil {
-				plugin.Logger(ctx).Error("hubspot_blog_post.listBlogPosts", "api_error", err)
-				return nil, err
-			}
-			for _, blogPost := range response.Results {
-				d.StreamListItem(ctx, blogPost)
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	params.Set("archived", strconv.FormatBool(archived))
 
-				// Context can be cancelled due to manual cancellation or the limit has been hit
-				if d.RowsRemaining(ctx) == 0 {
-					return nil, nil
-				}
-			}
-			if !response.Paging.HasNext() {
-				break
-			}
-			after = response.Paging.Next.After
-		} else {
-			response, _, err := client.BlogPostsApi.GetPage(context).Limit(maxLimit).After(after).Archived(archived).Execute()
-			if err != nil {
-				plugin.Logger(ctx).Error("hubspot_blog_post.listBlogPosts", "api_error", err)
-				return nil, err
+	// The SDK does not support property filters, so the listing API is called directly.
+	// The filters apply to the published versions, so they are skipped for drafts whose values may differ.
+	if version == blogPostVersionPublished {
+		setBlogPostTimeParams(d, params, "created", "createdAt")
+		setBlogPostTimeParams(d, params, "updated", "updatedAt")
+		setBlogPostTimeParams(d, params, "publish_date", "publishDate")
+		for column, property := range blogPostPropertyFilters {
+			if value := d.EqualsQualString(column); value != "" {
+				params.Set(property, value)
 			}
-			for _, blogPost := range response.Results {
-				d.StreamListItem(ctx, blogPost)
+		}
+	}
 
-				// Context can be cancelled due to manual cancellation or the limit has been hit
-				if d.RowsRemaining(ctx) == 0 {
-					return nil, nil
+	var client *blog_posts.APIClient
+	var authContext context.Context
+	if version == blogPostVersionDraft {
+		authorizer, err := connect(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_blog_post.listBlogPosts", "connection_error", err)
+			return nil, err
+		}
+		authContext = hubspot.WithAuthorizer(context.Background(), authorizer)
+		client = blog_posts.NewAPIClient(blog_posts.NewConfiguration())
+	}
+
+	for {
+		var response BlogPostsResponse
+		err := getHubSpotApiResponse(ctx, d, "/cms/v3/blogs/posts", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_blog_post.listBlogPosts", "api_error", err)
+			return nil, err
+		}
+		for _, blogPost := range response.Results {
+			// The listing API only returns the published versions, so the drafts are fetched one by one
+			if version == blogPostVersionDraft {
+				draft, _, err := client.BlogPostsApi.GetDraftByID(authContext, blogPost.Id).Execute()
+				if err != nil {
+					plugin.Logger(ctx).Error("hubspot_blog_post.listBlogPosts", "api_error", err)
+					return nil, err
 				}
+				blogPost = *draft
 			}
-			if !response.Paging.HasNext() {
-				break
+			d.StreamListItem(ctx, blogPost)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
 			}
-			after = response.Paging.Next.After
 		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
 	}
 
 	return nil, nil
 }
 
+// setBlogPostTimeParams :: map the quals of a timestamp column to the operator filters of the listing API, which take the timestamps in milliseconds
+func setBlogPostTimeParams(d *plugin.QueryData, params url.Values, column string, property string) {
+	if d.Quals[column] == nil {
+		return
+	}
+	for _, q := range d.Quals[column].Quals {
+		operator, ok := blogPostTimeOperators[q.Operator]
+		if !ok {
+			continue
+		}
+		params.Set(property+"__"+operator, strconv.FormatInt(q.Value.GetTimestampValue().AsTime().UnixMilli(), 10))
+	}
+}
+
 //// HYDRATE FUNCTIONS
 
 func getBlogPost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -439,6 +550,11 @@ func getBlogPost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 		return nil, nil
 	}
 
+	version, err := getBlogPostVersion(d)
+	if err != nil {
+		return nil, err
+	}
+
 	authorizer, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("hubspot_blog_post.getBlogPost", "connection_error", err)
@@ -447,7 +563,12 @@ func getBlogPost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	context := hubspot.WithAuthorizer(context.Background(), authorizer)
 	client := blog_posts.NewAPIClient(blog_posts.NewConfiguration())
 
-	blogPost, _, err := client.BlogPostsApi.GetByID(context, id).Execute()
+	var blogPost *blog_posts.BlogPost
+	if version == blogPostVersionDraft {
+		blogPost, _, err = client.BlogPostsApi.GetDraftByID(context, id).Execute()
+	} else {
+		blogPost, _, err = client.BlogPostsApi.GetByID(context, id).Execute()
+	}
 	if err != nil {
 		plugin.Logger(ctx).Error("hubspot_blog_post.getBlogPost", "api_error", err)
 		return nil, err
@@ -455,3 +576,16 @@ func getBlogPost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 
 	return blogPost, nil
 }
+
+// getBlogPostVersion :: return the blog post version requested in the quals, which defaults to published
+func getBlogPostVersion(d *plugin.QueryData) (string, error) {
+	version := d.EqualsQualString("version")
+	switch version {
+	case "", blogPostVersionPublished:
+		return blogPostVersionPublished, nil
+	case blogPostVersionDraft:
+		return blogPostVersionDraft, nil
+	}
+
+	return "", fmt.Errorf("invalid version '%s', valid values are '%s' and '%s'", version, blogPostVersionPublished, blogPostVersionDraft)
+}
@@ -0,0 +1,161 @@
+package hubspot
+
+import (
+	"context"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/blog_posts"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotBlogPostRevision(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_blog_post_revision",
+		Description: "List of HubSpot Blog Post revisions.",
+		List: &plugin.ListConfig{
+			Hydrate:    listBlogPostRevisions,
+			KeyColumns: plugin.SingleColumn("blog_post_id"),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getBlogPostRevision,
+			KeyColumns: plugin.AllColumns([]string{"blog_post_id", "id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the revision.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "blog_post_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the Blog Post the revision belongs to.",
+				Transform:   transform.FromQual("blog_post_id"),
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the revision was saved.",
+			},
+			{
+				Name:        "user_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the user who saved the revision.",
+				Transform:   transform.FromField("User.Id"),
+			},
+			{
+				Name:        "user_email",
+				Type:        proto.ColumnType_STRING,
+				Description: "The email address of the user who saved the revision.",
+				Transform:   transform.FromField("User.Email"),
+			},
+			{
+				Name:        "user_full_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The first and last name of the user who saved the revision.",
+				Transform:   transform.FromField("User.FullName"),
+			},
+			{
+				Name:        "object",
+				Type:        proto.ColumnType_JSON,
+				Description: "The full Blog Post object as it was at the time of the revision.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Object.Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBlogPostRevisions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	blogPostId := d.EqualsQualString("blog_post_id")
+
+	// check if blog_post_id is empty
+	if blogPostId == "" {
+		return nil, nil
+	}
+
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_blog_post_revision.listBlogPostRevisions", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := blog_posts.NewAPIClient(blog_posts.NewConfiguration())
+
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	var after string = ""
+
+	for {
+		request := client.BlogPostsApi.GetPreviousVersions(context, blogPostId).Limit(maxLimit)
+		if after != "" {
+			request = request.After(after)
+		}
+		response, _, err := request.Execute()
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_blog_post_revision.listBlogPostRevisions", "api_error", err)
+			return nil, err
+		}
+		for _, revision := range response.Results {
+			d.StreamListItem(ctx, revision)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if !response.Paging.HasNext() {
+			break
+		}
+		after = response.Paging.Next.After
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBlogPostRevision(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	blogPostId := d.EqualsQualString("blog_post_id")
+	id := d.EqualsQualString("id")
+
+	// check if blog_post_id or id is empty
+	if blogPostId == "" || id == "" {
+		return nil, nil
+	}
+
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_blog_post_revision.getBlogPostRevision", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := blog_posts.NewAPIClient(blog_posts.NewConfiguration())
+
+	revision, _, err := client.BlogPostsApi.GetPreviousVersion(context, blogPostId, id).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_blog_post_revision.getBlogPostRevision", "api_error", err)
+		return nil, err
+	}
+
+	return *revision, nil
+}
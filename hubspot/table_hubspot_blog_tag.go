@@ -0,0 +1,182 @@
+package hubspot
+
+import (
+	"context"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/tags"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotBlogTag(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_blog_tag",
+		Description: "List of HubSpot Blog Tags.",
+		List: &plugin.ListConfig{
+			Hydrate: listBlogTags,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getBlogTag,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the Blog Tag.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the Blog Tag.",
+			},
+			{
+				Name:        "language",
+				Type:        proto.ColumnType_STRING,
+				Description: "The explicitly defined ISO 639 language code of the Blog Tag.",
+			},
+			{
+				Name:        "translated_from_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "ID of the primary Blog Tag this object was translated from.",
+				Transform:   transform.FromField("TranslatedFromId"),
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the Blog Tag is archived.",
+				Transform:   transform.FromField("DeletedAt").Transform(archivedFromDeletedAt),
+			},
+			{
+				Name:        "created",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the Blog Tag was created.",
+			},
+			{
+				Name:        "updated",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the Blog Tag was last updated.",
+			},
+			{
+				Name:        "deleted_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the Blog Tag was deleted.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBlogTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_blog_tag.listBlogTags", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := tags.NewAPIClient(tags.NewConfiguration())
+
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	var after string = ""
+	archived := false
+
+	if d.EqualsQuals["archived"] != nil {
+		archived = d.EqualsQuals["archived"].GetBoolValue()
+	}
+
+	for {
+		if after == "" {
+			response, _, err := client.BlogTagsApi.GetPage(context).Limit(maxLimit).Archived(archived).Execute()
+			if err != nil {
+				plugin.Logger(ctx).Error("hubspot_blog_tag.listBlogTags", "api_error", err)
+				return nil, err
+			}
+			for _, blogTag := range response.Results {
+				d.StreamListItem(ctx, blogTag)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+			if !response.Paging.HasNext() {
+				break
+			}
+			after = response.Paging.Next.After
+		} else {
+			response, _, err := client.BlogTagsApi.GetPage(context).Limit(maxLimit).After(after).Archived(archived).Execute()
+			if err != nil {
+				plugin.Logger(ctx).Error("hubspot_blog_tag.listBlogTags", "api_error", err)
+				return nil, err
+			}
+			for _, blogTag := range response.Results {
+				d.StreamListItem(ctx, blogTag)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+			if !response.Paging.HasNext() {
+				break
+			}
+			after = response.Paging.Next.After
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBlogTag(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_blog_tag.getBlogTag", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := tags.NewAPIClient(tags.NewConfiguration())
+
+	blogTag, _, err := client.BlogTagsApi.GetByID(context, id).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_blog_tag.getBlogTag", "api_error", err)
+		return nil, err
+	}
+
+	return *blogTag, nil
+}
@@ -0,0 +1,225 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// campaignProperties are the campaign properties requested from the campaigns API
+var campaignProperties = []string{
+	"hs_name",
+	"hs_campaign_status",
+	"hs_start_date",
+	"hs_end_date",
+	"hs_notes",
+	"hs_audience",
+	"hs_currency_code",
+	"hs_owner",
+	"hs_utm",
+	"hs_color_hex",
+}
+
+//// TABLE DEFINITION
+
+func tableHubSpotCampaign(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_campaign",
+		Description: "List of HubSpot marketing Campaigns.",
+		List: &plugin.ListConfig{
+			Hydrate: listCampaigns,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getCampaign,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The GUID of the campaign.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the campaign.",
+				Transform:   transform.FromP(extractCampaignProperty, "hs_name"),
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "The status of the campaign, e.g. planned, in_progress, active, paused, completed.",
+				Transform:   transform.FromP(extractCampaignProperty, "hs_campaign_status"),
+			},
+			{
+				Name:        "start_date",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The start date of the campaign.",
+				Transform:   transform.FromP(extractCampaignProperty, "hs_start_date"),
+			},
+			{
+				Name:        "end_date",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The end date of the campaign.",
+				Transform:   transform.FromP(extractCampaignProperty, "hs_end_date"),
+			},
+			{
+				Name:        "notes",
+				Type:        proto.ColumnType_STRING,
+				Description: "The notes of the campaign.",
+				Transform:   transform.FromP(extractCampaignProperty, "hs_notes"),
+			},
+			{
+				Name:        "audience",
+				Type:        proto.ColumnType_STRING,
+				Description: "The target audience of the campaign.",
+				Transform:   transform.FromP(extractCampaignProperty, "hs_audience"),
+			},
+			{
+				Name:        "currency_code",
+				Type:        proto.ColumnType_STRING,
+				Description: "The currency code of the campaign budget.",
+				Transform:   transform.FromP(extractCampaignProperty, "hs_currency_code"),
+			},
+			{
+				Name:        "owner_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the user who owns the campaign.",
+				Transform:   transform.FromP(extractCampaignProperty, "hs_owner"),
+			},
+			{
+				Name:        "utm",
+				Type:        proto.ColumnType_STRING,
+				Description: "The UTM campaign value of the campaign.",
+				Transform:   transform.FromP(extractCampaignProperty, "hs_utm"),
+			},
+			{
+				Name:        "color_hex",
+				Type:        proto.ColumnType_STRING,
+				Description: "The color of the campaign in the HubSpot UI.",
+				Transform:   transform.FromP(extractCampaignProperty, "hs_color_hex"),
+			},
+			{
+				Name:        "properties",
+				Type:        proto.ColumnType_JSON,
+				Description: "The properties of the campaign.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the campaign was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the campaign was last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractCampaignProperty, "hs_name"),
+			},
+		}),
+	}
+}
+
+type Campaign struct {
+	Id         string            `json:"id"`
+	Properties map[string]string `json:"properties"`
+	CreatedAt  *time.Time        `json:"createdAt"`
+	UpdatedAt  *time.Time        `json:"updatedAt"`
+}
+
+type CampaignsResponse struct {
+	Results []Campaign     `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listCampaigns(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	params.Set("properties", strings.Join(campaignProperties, ","))
+
+	for {
+		var response CampaignsResponse
+		err := getHubSpotApiResponse(ctx, d, "/marketing/v3/campaigns", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_campaign.listCampaigns", "api_error", err)
+			return nil, err
+		}
+		for _, campaign := range response.Results {
+			d.StreamListItem(ctx, campaign)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCampaign(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	params := url.Values{}
+	params.Set("properties", strings.Join(campaignProperties, ","))
+
+	var campaign Campaign
+	err := getHubSpotApiResponse(ctx, d, "/marketing/v3/campaigns/"+url.PathEscape(id), params, &campaign)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_campaign.getCampaign", "api_error", err)
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractCampaignProperty(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	ob := d.HydrateItem.(Campaign).Properties
+	if ob == nil {
+		return nil, nil
+	}
+	param := d.Param.(string)
+	if ob[param] == "" {
+		return nil, nil
+	}
+
+	return ob[param], nil
+}
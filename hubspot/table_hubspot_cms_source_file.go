@@ -0,0 +1,284 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const (
+	cmsSourceEnvironmentPublished = "published"
+	cmsSourceEnvironmentDraft     = "draft"
+
+	// The folder of the default HubSpot templates and modules, which is only listed when requested by path
+	cmsSourceHubSpotFolder = "@hubspot"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotCMSSourceFile(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_cms_source_file",
+		Description: "List of HubSpot design manager source files and folders.",
+		List: &plugin.ListConfig{
+			Hydrate: listCMSSourceFiles,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:      "path",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "~~"},
+				},
+				{
+					Name:    "environment",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "path",
+				Type:        proto.ColumnType_STRING,
+				Description: "The path of the file or folder in the design manager.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the file or folder.",
+			},
+			{
+				Name:        "folder",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the path points to a folder.",
+			},
+			{
+				Name:        "children",
+				Type:        proto.ColumnType_JSON,
+				Description: "The names of the files and folders within the folder.",
+			},
+			{
+				Name:        "environment",
+				Type:        proto.ColumnType_STRING,
+				Description: "The environment of the file, either published or draft. Defaults to published.",
+				Transform:   transform.FromQual("environment"),
+				Default:     cmsSourceEnvironmentPublished,
+			},
+			{
+				Name:        "size",
+				Type:        proto.ColumnType_INT,
+				Description: "The size of the file in bytes. The metadata API does not return the size, so it is measured from the content and is only available when a single path is requested.",
+				Hydrate:     getCMSSourceFileContent,
+				Transform:   transform.FromValue().Transform(cmsSourceFileSize),
+			},
+			{
+				Name:        "content",
+				Type:        proto.ColumnType_STRING,
+				Description: "The content of the file. Only available when a single path is requested.",
+				Hydrate:     getCMSSourceFileContent,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the file was created.",
+				Transform:   transform.FromField("CreatedAt").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the file was last updated.",
+				Transform:   transform.FromField("UpdatedAt").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "archived_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the file was archived.",
+				Transform:   transform.FromField("ArchivedAt").Transform(transform.UnixMsToTimestamp),
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type CMSSourceFile struct {
+	Id         string   `json:"id"`
+	Name       string   `json:"name"`
+	Folder     bool     `json:"folder"`
+	Children   []string `json:"children"`
+	CreatedAt  int64    `json:"createdAt"`
+	UpdatedAt  int64    `json:"updatedAt"`
+	ArchivedAt int64    `json:"archivedAt"`
+}
+
+//// LIST FUNCTION
+
+func listCMSSourceFiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	environment, err := getCMSSourceEnvironment(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// A single file or folder has been requested
+	if path := d.EqualsQualString("path"); path != "" {
+		file, err := getCMSSourceFileMetadata(ctx, d, environment, path)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_cms_source_file.listCMSSourceFiles", "api_error", err)
+			return nil, err
+		}
+		d.StreamListItem(ctx, *file)
+		return nil, nil
+	}
+
+	// The API has no listing endpoint, so the folders are walked from the deepest folder of the requested path prefix
+	prefix := getCMSSourcePathPrefix(d)
+	start := ""
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		start = prefix[:i]
+	}
+	root, err := getCMSSourceFileMetadata(ctx, d, environment, start)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_cms_source_file.listCMSSourceFiles", "api_error", err)
+		return nil, err
+	}
+	// The children of the root are addressed by their name alone, and those of any other folder by its path
+	root.Id = start
+	folders := []CMSSourceFile{*root}
+
+	for len(folders) > 0 {
+		folder := folders[0]
+		folders = folders[1:]
+
+		for _, child := range folder.Children {
+			if folder.Id == "" && child == cmsSourceHubSpotFolder && prefix == "" {
+				continue
+			}
+
+			path := child
+			if folder.Id != "" {
+				path = folder.Id + "/" + child
+			}
+			if !strings.HasPrefix(path, prefix) {
+				continue
+			}
+			file, err := getCMSSourceFileMetadata(ctx, d, environment, path)
+			if err != nil {
+				plugin.Logger(ctx).Error("hubspot_cms_source_file.listCMSSourceFiles", "api_error", err)
+				return nil, err
+			}
+			d.StreamListItem(ctx, *file)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+
+			if file.Folder {
+				folders = append(folders, *file)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCMSSourceFileContent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	file := h.Item.(CMSSourceFile)
+
+	// The content is only fetched when a single file is requested
+	if file.Folder || d.EqualsQualString("path") == "" {
+		return nil, nil
+	}
+
+	environment, err := getCMSSourceEnvironment(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var content string
+	err = getHubSpotApiResponse(ctx, d, "/cms/v3/source-code/"+environment+"/content/"+escapeCMSSourcePath(file.Id), nil, &content)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_cms_source_file.getCMSSourceFileContent", "api_error", err)
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// getCMSSourceFileMetadata :: return the metadata of the file or folder at the given path
+func getCMSSourceFileMetadata(ctx context.Context, d *plugin.QueryData, environment string, path string) (*CMSSourceFile, error) {
+	var file CMSSourceFile
+	err := getHubSpotApiResponse(ctx, d, "/cms/v3/source-code/"+environment+"/metadata/"+escapeCMSSourcePath(path), nil, &file)
+	if err != nil {
+		return nil, err
+	}
+
+	return &file, nil
+}
+
+// getCMSSourcePathPrefix :: return the longest literal prefix of the like patterns on the path, up to their first wildcard
+func getCMSSourcePathPrefix(d *plugin.QueryData) string {
+	prefix := ""
+	if d.Quals["path"] == nil {
+		return prefix
+	}
+	for _, q := range d.Quals["path"].Quals {
+		if q.Operator != quals.QualOperatorLike {
+			continue
+		}
+		pattern := q.Value.GetStringValue()
+		if i := strings.IndexAny(pattern, `%_\`); i >= 0 {
+			pattern = pattern[:i]
+		}
+		if len(pattern) > len(prefix) {
+			prefix = pattern
+		}
+	}
+	return prefix
+}
+
+// getCMSSourceEnvironment :: return the environment requested in the quals, which defaults to published
+func getCMSSourceEnvironment(d *plugin.QueryData) (string, error) {
+	environment := d.EqualsQualString("environment")
+	switch environment {
+	case "", cmsSourceEnvironmentPublished:
+		return cmsSourceEnvironmentPublished, nil
+	case cmsSourceEnvironmentDraft:
+		return cmsSourceEnvironmentDraft, nil
+	}
+
+	return "", fmt.Errorf("invalid environment '%s', valid values are '%s' and '%s'", environment, cmsSourceEnvironmentPublished, cmsSourceEnvironmentDraft)
+}
+
+// escapeCMSSourcePath :: escape each segment of a design manager path, keeping the separators
+func escapeCMSSourcePath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cmsSourceFileSize(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	content, ok := d.Value.(string)
+	if !ok {
+		return nil, nil
+	}
+	return len(content), nil
+}
@@ -0,0 +1,161 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotConversationInbox(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_conversation_inbox",
+		Description: "List of HubSpot Conversations inboxes.",
+		List: &plugin.ListConfig{
+			Hydrate: listConversationInboxes,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getConversationInbox,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the inbox.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the inbox.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the inbox, e.g. INBOX or HELP_DESK.",
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the inbox is archived.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the inbox was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the inbox was last updated.",
+			},
+			{
+				Name:        "archived_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the inbox was archived.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type ConversationInbox struct {
+	Id         string     `json:"id"`
+	Name       string     `json:"name"`
+	Type       string     `json:"type"`
+	Archived   bool       `json:"archived"`
+	CreatedAt  *time.Time `json:"createdAt"`
+	UpdatedAt  *time.Time `json:"updatedAt"`
+	ArchivedAt *time.Time `json:"archivedAt"`
+}
+
+type ConversationInboxesResponse struct {
+	Results []ConversationInbox `json:"results"`
+	Paging  *hubSpotPaging      `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listConversationInboxes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	archived := false
+
+	if d.EqualsQuals["archived"] != nil {
+		archived = d.EqualsQuals["archived"].GetBoolValue()
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	params.Set("archived", strconv.FormatBool(archived))
+
+	for {
+		var response ConversationInboxesResponse
+		err := getHubSpotApiResponse(ctx, d, "/conversations/v3/conversations/inboxes", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_conversation_inbox.listConversationInboxes", "api_error", err)
+			return nil, err
+		}
+		for _, inbox := range response.Results {
+			d.StreamListItem(ctx, inbox)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getConversationInbox(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	var inbox ConversationInbox
+	err := getHubSpotApiResponse(ctx, d, "/conversations/v3/conversations/inboxes/"+url.PathEscape(id), nil, &inbox)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_conversation_inbox.getConversationInbox", "api_error", err)
+		return nil, err
+	}
+
+	return inbox, nil
+}
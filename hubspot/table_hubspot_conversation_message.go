@@ -0,0 +1,242 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotConversationMessage(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_conversation_message",
+		Description: "List of HubSpot Conversations messages of a thread.",
+		List: &plugin.ListConfig{
+			Hydrate:    listConversationMessages,
+			KeyColumns: plugin.SingleColumn("thread_id"),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getConversationMessage,
+			KeyColumns: plugin.AllColumns([]string{"thread_id", "id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the message.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "thread_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the thread the message belongs to.",
+				Transform:   transform.FromQual("thread_id"),
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the message, e.g. MESSAGE, COMMENT, WELCOME_MESSAGE or THREAD_STATUS_CHANGE.",
+			},
+			{
+				Name:        "direction",
+				Type:        proto.ColumnType_STRING,
+				Description: "The direction of the message, either INCOMING or OUTGOING.",
+			},
+			{
+				Name:        "created_by",
+				Type:        proto.ColumnType_STRING,
+				Description: "The actor ID of the creator of the message, e.g. A-12345 for a user or V-12345 for a visitor.",
+			},
+			{
+				Name:        "text",
+				Type:        proto.ColumnType_STRING,
+				Description: "The plain text content of the message.",
+			},
+			{
+				Name:        "rich_text",
+				Type:        proto.ColumnType_STRING,
+				Description: "The HTML content of the message.",
+			},
+			{
+				Name:        "subject",
+				Type:        proto.ColumnType_STRING,
+				Description: "The subject of the message, for email messages.",
+			},
+			{
+				Name:        "channel_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the channel the message was sent on.",
+				Transform:   transform.FromField("ChannelId"),
+			},
+			{
+				Name:        "channel_account_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the channel account the message was sent on.",
+				Transform:   transform.FromField("ChannelAccountId"),
+			},
+			{
+				Name:        "in_reply_to_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the message this message replies to.",
+				Transform:   transform.FromField("InReplyToId"),
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "The delivery status of the message, e.g. SENT, RECEIVED, READ or FAILED.",
+				Transform:   transform.FromField("Status.StatusType"),
+			},
+			{
+				Name:        "truncation_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Indicates whether the content of the message has been truncated.",
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the message is archived.",
+			},
+			{
+				Name:        "senders",
+				Type:        proto.ColumnType_JSON,
+				Description: "The senders of the message.",
+			},
+			{
+				Name:        "recipients",
+				Type:        proto.ColumnType_JSON,
+				Description: "The recipients of the message.",
+			},
+			{
+				Name:        "attachments",
+				Type:        proto.ColumnType_JSON,
+				Description: "The attachments of the message.",
+			},
+			{
+				Name:        "client",
+				Type:        proto.ColumnType_JSON,
+				Description: "The client which sent the message.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the message was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the message was last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type ConversationMessage struct {
+	Id               string      `json:"id"`
+	Type             string      `json:"type"`
+	Direction        string      `json:"direction"`
+	CreatedBy        string      `json:"createdBy"`
+	Text             string      `json:"text"`
+	RichText         string      `json:"richText"`
+	Subject          string      `json:"subject"`
+	ChannelId        string      `json:"channelId"`
+	ChannelAccountId string      `json:"channelAccountId"`
+	InReplyToId      string      `json:"inReplyToId"`
+	TruncationStatus string      `json:"truncationStatus"`
+	Archived         bool        `json:"archived"`
+	Senders          interface{} `json:"senders"`
+	Recipients       interface{} `json:"recipients"`
+	Attachments      interface{} `json:"attachments"`
+	Client           interface{} `json:"client"`
+	Status           struct {
+		StatusType string `json:"statusType"`
+	} `json:"status"`
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+type ConversationMessagesResponse struct {
+	Results []ConversationMessage `json:"results"`
+	Paging  *hubSpotPaging        `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listConversationMessages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	threadId := d.EqualsQualString("thread_id")
+
+	// check if thread_id is empty
+	if threadId == "" {
+		return nil, nil
+	}
+
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+
+	for {
+		var response ConversationMessagesResponse
+		err := getHubSpotApiResponse(ctx, d, "/conversations/v3/conversations/threads/"+url.PathEscape(threadId)+"/messages", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_conversation_message.listConversationMessages", "api_error", err)
+			return nil, err
+		}
+		for _, message := range response.Results {
+			d.StreamListItem(ctx, message)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getConversationMessage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	threadId := d.EqualsQualString("thread_id")
+	id := d.EqualsQualString("id")
+
+	// check if thread_id or id is empty
+	if threadId == "" || id == "" {
+		return nil, nil
+	}
+
+	var message ConversationMessage
+	err := getHubSpotApiResponse(ctx, d, "/conversations/v3/conversations/threads/"+url.PathEscape(threadId)+"/messages/"+url.PathEscape(id), nil, &message)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_conversation_message.getConversationMessage", "api_error", err)
+		return nil, err
+	}
+
+	return message, nil
+}
@@ -0,0 +1,237 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotConversationThread(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_conversation_thread",
+		Description: "List of HubSpot Conversations threads.",
+		List: &plugin.ListConfig{
+			Hydrate: listConversationThreads,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "inbox_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "associated_contact_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "status",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getConversationThread,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the thread.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "The status of the thread, either OPEN or CLOSED.",
+			},
+			{
+				Name:        "inbox_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the inbox the thread belongs to.",
+				Transform:   transform.FromField("InboxId"),
+			},
+			{
+				Name:        "assigned_to",
+				Type:        proto.ColumnType_STRING,
+				Description: "The actor ID of the user the thread is assigned to, e.g. A-12345.",
+			},
+			{
+				Name:        "associated_contact_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the contact the thread is associated with.",
+				Transform:   transform.FromField("AssociatedContactId"),
+			},
+			{
+				Name:        "associated_ticket_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the ticket the thread is associated with.",
+				Transform:   transform.FromField("ThreadAssociations.AssociatedTicketId"),
+			},
+			{
+				Name:        "original_channel_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the channel the thread was started on.",
+				Transform:   transform.FromField("OriginalChannelId"),
+			},
+			{
+				Name:        "original_channel_account_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the channel account the thread was started on.",
+				Transform:   transform.FromField("OriginalChannelAccountId"),
+			},
+			{
+				Name:        "spam",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the thread is marked as spam.",
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the thread is archived.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the thread was created.",
+			},
+			{
+				Name:        "closed_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the thread was closed.",
+			},
+			{
+				Name:        "latest_message_timestamp",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp of the latest message in the thread.",
+			},
+			{
+				Name:        "latest_message_sent_timestamp",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp of the latest message sent by a user.",
+			},
+			{
+				Name:        "latest_message_received_timestamp",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp of the latest message received from a visitor or contact.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type ConversationThread struct {
+	Id                             string     `json:"id"`
+	Status                         string     `json:"status"`
+	InboxId                        string     `json:"inboxId"`
+	AssignedTo                     string     `json:"assignedTo"`
+	AssociatedContactId            string     `json:"associatedContactId"`
+	OriginalChannelId              string     `json:"originalChannelId"`
+	OriginalChannelAccountId       string     `json:"originalChannelAccountId"`
+	Spam                           bool       `json:"spam"`
+	Archived                       bool       `json:"archived"`
+	CreatedAt                      *time.Time `json:"createdAt"`
+	ClosedAt                       *time.Time `json:"closedAt"`
+	LatestMessageTimestamp         *time.Time `json:"latestMessageTimestamp"`
+	LatestMessageSentTimestamp     *time.Time `json:"latestMessageSentTimestamp"`
+	LatestMessageReceivedTimestamp *time.Time `json:"latestMessageReceivedTimestamp"`
+	ThreadAssociations             struct {
+		AssociatedTicketId string `json:"associatedTicketId"`
+	} `json:"threadAssociations"`
+}
+
+type ConversationThreadsResponse struct {
+	Results []ConversationThread `json:"results"`
+	Paging  *hubSpotPaging       `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listConversationThreads(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	archived := false
+
+	if d.EqualsQuals["archived"] != nil {
+		archived = d.EqualsQuals["archived"].GetBoolValue()
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	params.Set("archived", strconv.FormatBool(archived))
+	if inboxId := d.EqualsQualString("inbox_id"); inboxId != "" {
+		params.Set("inboxId", inboxId)
+	}
+	if contactId := d.EqualsQualString("associated_contact_id"); contactId != "" {
+		params.Set("associatedContactId", contactId)
+	}
+	if status := d.EqualsQualString("status"); status != "" {
+		params.Set("threadStatus", status)
+	}
+
+	for {
+		var response ConversationThreadsResponse
+		err := getHubSpotApiResponse(ctx, d, "/conversations/v3/conversations/threads", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_conversation_thread.listConversationThreads", "api_error", err)
+			return nil, err
+		}
+		for _, thread := range response.Results {
+			d.StreamListItem(ctx, thread)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getConversationThread(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	var thread ConversationThread
+	err := getHubSpotApiResponse(ctx, d, "/conversations/v3/conversations/threads/"+url.PathEscape(id), nil, &thread)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_conversation_thread.getConversationThread", "api_error", err)
+		return nil, err
+	}
+
+	return thread, nil
+}
@@ -2,6 +2,8 @@ package hubspot
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	hubspot "github.com/clarkmcc/go-hubspot"
 	"github.com/clarkmcc/go-hubspot/generated/v3/domains"
@@ -23,14 +25,7 @@ func tableHubSpotDomain(ctx context.Context) *plugin.Table {
 			Hydrate:    getDomain,
 			KeyColumns: plugin.SingleColumn("id"),
 		},
-		Columns: []*plugin.Column{
-			{
-				Name:        "portal_id",
-				Type:        proto.ColumnType_INT,
-				Description: "The portal ID.",
-				Hydrate:     getPortalId,
-				Transform:   transform.FromValue(),
-			},
+		Columns: commonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Type:        proto.ColumnType_STRING,
@@ -334,6 +329,18 @@ func tableHubSpotDomain(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_BOOL,
 				Description: "Indicates if the domain is a staging domain.",
 			},
+			{
+				Name:        "health_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "The health of the domain derived from its DNS, resolution and SSL checks: HEALTHY, WARNING or UNHEALTHY.",
+				Transform:   transform.From(domainHealthStatus),
+			},
+			{
+				Name:        "health_issues",
+				Type:        proto.ColumnType_JSON,
+				Description: "The failing health checks of the domain, each with a severity and a human-readable explanation.",
+				Transform:   transform.From(domainHealthIssuesTransform),
+			},
 
 			/// Steampipe standard columns
 			{
@@ -342,10 +349,25 @@ func tableHubSpotDomain(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Domain"),
 			},
-		},
+		}),
 	}
 }
 
+type DomainHealthIssue struct {
+	Check       string `json:"check"`
+	Severity    string `json:"severity"`
+	Explanation string `json:"explanation"`
+}
+
+const (
+	domainHealthStatusHealthy   = "HEALTHY"
+	domainHealthStatusWarning   = "WARNING"
+	domainHealthStatusUnhealthy = "UNHEALTHY"
+
+	domainHealthSeverityError   = "ERROR"
+	domainHealthSeverityWarning = "WARNING"
+)
+
 //// LIST FUNCTION
 
 func listDomains(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -436,3 +458,129 @@ func getDomain(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 
 	return domain, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+func domainHealthStatus(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	domain, ok := domainFromHydrateItem(d.HydrateItem)
+	if !ok {
+		return nil, nil
+	}
+
+	status := domainHealthStatusHealthy
+	for _, issue := range domainHealthIssues(domain) {
+		if issue.Severity == domainHealthSeverityError {
+			return domainHealthStatusUnhealthy, nil
+		}
+		status = domainHealthStatusWarning
+	}
+
+	return status, nil
+}
+
+func domainHealthIssuesTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	domain, ok := domainFromHydrateItem(d.HydrateItem)
+	if !ok {
+		return nil, nil
+	}
+
+	return domainHealthIssues(domain), nil
+}
+
+// The list function streams domains by value while the get function returns a pointer
+func domainFromHydrateItem(item interface{}) (domains.Domain, bool) {
+	switch domain := item.(type) {
+	case domains.Domain:
+		return domain, true
+	case *domains.Domain:
+		if domain != nil {
+			return *domain, true
+		}
+	}
+	return domains.Domain{}, false
+}
+
+// domainHealthIssues :: evaluate the DNS, resolution, SSL and setup fields of a domain the way the HubSpot domain settings page reports them
+func domainHealthIssues(domain domains.Domain) []DomainHealthIssue {
+	issues := []DomainHealthIssue{}
+
+	if !domain.IsSetupComplete {
+		issues = append(issues, DomainHealthIssue{
+			Check:       "SETUP_INCOMPLETE",
+			Severity:    domainHealthSeverityError,
+			Explanation: "The domain setup has not been completed in HubSpot.",
+		})
+	}
+	if !domain.IsResolving {
+		issues = append(issues, DomainHealthIssue{
+			Check:       "NOT_RESOLVING",
+			Severity:    domainHealthSeverityError,
+			Explanation: "The domain does not resolve to HubSpot, so content published on it is not reachable.",
+		})
+	} else if domain.ManuallyMarkedAsResolving && !domain.IsResolvingIgnoringManuallyMarkedAsResolving {
+		issues = append(issues, DomainHealthIssue{
+			Check:       "MANUALLY_MARKED_AS_RESOLVING",
+			Severity:    domainHealthSeverityWarning,
+			Explanation: "The domain was manually marked as resolving but HubSpot's own resolution check fails.",
+		})
+	}
+	if !domain.IsDnsCorrect {
+		issues = append(issues, DomainHealthIssue{
+			Check:       "DNS_INCORRECT",
+			Severity:    domainHealthSeverityError,
+			Explanation: "The DNS records of the domain are not configured as HubSpot expects.",
+		})
+	}
+	if domain.CorrectCname != "" && normalizeDomainName(domain.ActualCname) != normalizeDomainName(domain.CorrectCname) {
+		explanation := fmt.Sprintf("The CNAME record of the domain should point to %s but has no value.", domain.CorrectCname)
+		if domain.ActualCname != "" {
+			explanation = fmt.Sprintf("The CNAME record of the domain points to %s instead of %s.", domain.ActualCname, domain.CorrectCname)
+		}
+		issues = append(issues, DomainHealthIssue{
+			Check:       "CNAME_MISMATCH",
+			Severity:    domainHealthSeverityError,
+			Explanation: explanation,
+		})
+	}
+	if domain.ConsecutiveNonResolvingCount > 0 {
+		issues = append(issues, DomainHealthIssue{
+			Check:       "CONSECUTIVE_NON_RESOLVING",
+			Severity:    domainHealthSeverityWarning,
+			Explanation: fmt.Sprintf("The last %d resolution checks of the domain failed.", domain.ConsecutiveNonResolvingCount),
+		})
+	}
+	if !domain.IsSslEnabled {
+		issues = append(issues, DomainHealthIssue{
+			Check:       "SSL_NOT_ENABLED",
+			Severity:    domainHealthSeverityError,
+			Explanation: "SSL is not enabled for the domain, so content is not served over HTTPS.",
+		})
+	} else if !domain.IsSslOnly {
+		issues = append(issues, DomainHealthIssue{
+			Check:       "SSL_NOT_ENFORCED",
+			Severity:    domainHealthSeverityWarning,
+			Explanation: "The domain serves content over HTTP as well as HTTPS.",
+		})
+	}
+	switch domain.ApexResolutionStatus {
+	case "ERROR":
+		issues = append(issues, DomainHealthIssue{
+			Check:       "APEX_RESOLUTION_ERROR",
+			Severity:    domainHealthSeverityError,
+			Explanation: fmt.Sprintf("The apex domain %s fails to resolve.", domain.ApexDomain),
+		})
+	case "SUGGEST_RESOLVING":
+		issues = append(issues, DomainHealthIssue{
+			Check:       "APEX_NOT_RESOLVING",
+			Severity:    domainHealthSeverityWarning,
+			Explanation: fmt.Sprintf("The apex domain %s does not redirect to this domain.", domain.ApexDomain),
+		})
+	}
+
+	return issues
+}
+
+// normalizeDomainName :: DNS names are case insensitive and may be written fully qualified with a trailing dot
+func normalizeDomainName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
@@ -0,0 +1,107 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotExport(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_export",
+		Description: "Status of a HubSpot CRM export.",
+		List: &plugin.ListConfig{
+			Hydrate:    listExports,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the export task.",
+				Transform:   transform.FromQual("id"),
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "The status of the export, e.g. PENDING, PROCESSING, CANCELED or COMPLETE.",
+			},
+			{
+				Name:        "result",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URL to download the exported file from, once the export is complete.",
+			},
+			{
+				Name:        "num_errors",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of errors of the export.",
+			},
+			{
+				Name:        "errors",
+				Type:        proto.ColumnType_JSON,
+				Description: "The errors of the export.",
+			},
+			{
+				Name:        "requested_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the export was requested.",
+			},
+			{
+				Name:        "started_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the export was started.",
+			},
+			{
+				Name:        "completed_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the export was completed.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("id"),
+			},
+		}),
+	}
+}
+
+type ExportStatus struct {
+	Status      string      `json:"status"`
+	Result      string      `json:"result"`
+	NumErrors   int64       `json:"numErrors"`
+	Errors      interface{} `json:"errors"`
+	RequestedAt *time.Time  `json:"requestedAt"`
+	StartedAt   *time.Time  `json:"startedAt"`
+	CompletedAt *time.Time  `json:"completedAt"`
+}
+
+//// LIST FUNCTION
+
+func listExports(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// The exports API has no listing endpoint, the status of a single export task is returned
+	var status ExportStatus
+	err := getHubSpotApiResponse(ctx, d, "/crm/v3/exports/export/async/tasks/"+url.PathEscape(id)+"/status", nil, &status)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_export.listExports", "api_error", err)
+		return nil, err
+	}
+	d.StreamListItem(ctx, status)
+
+	return nil, nil
+}
@@ -0,0 +1,259 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotFile(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_file",
+		Description: "List of HubSpot files in the file manager.",
+		List: &plugin.ListConfig{
+			Hydrate: listFiles,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "parent_folder_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "extension",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "type",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getFile,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the file.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the file.",
+			},
+			{
+				Name:        "path",
+				Type:        proto.ColumnType_STRING,
+				Description: "The path of the file in the file manager.",
+			},
+			{
+				Name:        "extension",
+				Type:        proto.ColumnType_STRING,
+				Description: "The extension of the file, e.g. png or pdf.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the file, e.g. IMG, DOCUMENT, AUDIO, MOVIE or OTHER.",
+			},
+			{
+				Name:        "size",
+				Type:        proto.ColumnType_INT,
+				Description: "The size of the file in bytes.",
+			},
+			{
+				Name:        "height",
+				Type:        proto.ColumnType_INT,
+				Description: "The height of the image or video, in pixels.",
+			},
+			{
+				Name:        "width",
+				Type:        proto.ColumnType_INT,
+				Description: "The width of the image or video, in pixels.",
+			},
+			{
+				Name:        "encoding",
+				Type:        proto.ColumnType_STRING,
+				Description: "The encoding of the file.",
+			},
+			{
+				Name:        "access",
+				Type:        proto.ColumnType_STRING,
+				Description: "The access level of the file, e.g. PUBLIC_INDEXABLE, PUBLIC_NOT_INDEXABLE, HIDDEN_INDEXABLE, HIDDEN_NOT_INDEXABLE, HIDDEN_PRIVATE or PRIVATE.",
+			},
+			{
+				Name:        "url",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URL of the file.",
+			},
+			{
+				Name:        "default_hosting_url",
+				Type:        proto.ColumnType_STRING,
+				Description: "The default hosting URL of the file.",
+			},
+			{
+				Name:        "is_usable_in_content",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the file is shown in the file picker and can be used in content.",
+			},
+			{
+				Name:        "parent_folder_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the folder the file is in.",
+				Transform:   transform.FromField("ParentFolderId"),
+			},
+			{
+				Name:        "source_group",
+				Type:        proto.ColumnType_STRING,
+				Description: "The source which uploaded the file.",
+			},
+			{
+				Name:        "file_md5",
+				Type:        proto.ColumnType_STRING,
+				Description: "The MD5 hash of the file.",
+				Transform:   transform.FromField("FileMd5"),
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the file is archived.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the file was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the file was last updated.",
+			},
+			{
+				Name:        "archived_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the file was archived.",
+			},
+			{
+				Name:        "expires_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the file expires.",
+				Transform:   transform.FromField("ExpiresAt").Transform(transform.UnixMsToTimestamp),
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type File struct {
+	Id                string     `json:"id"`
+	Name              string     `json:"name"`
+	Path              string     `json:"path"`
+	Extension         string     `json:"extension"`
+	Type              string     `json:"type"`
+	Size              int64      `json:"size"`
+	Height            int64      `json:"height"`
+	Width             int64      `json:"width"`
+	Encoding          string     `json:"encoding"`
+	Access            string     `json:"access"`
+	Url               string     `json:"url"`
+	DefaultHostingUrl string     `json:"defaultHostingUrl"`
+	IsUsableInContent bool       `json:"isUsableInContent"`
+	ParentFolderId    string     `json:"parentFolderId"`
+	SourceGroup       string     `json:"sourceGroup"`
+	FileMd5           string     `json:"fileMd5"`
+	Archived          bool       `json:"archived"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	UpdatedAt         *time.Time `json:"updatedAt"`
+	ArchivedAt        *time.Time `json:"archivedAt"`
+	ExpiresAt         int64      `json:"expiresAt"`
+}
+
+type FilesResponse struct {
+	Results []File         `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listFiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	if parentFolderId := d.EqualsQualString("parent_folder_id"); parentFolderId != "" {
+		params.Set("parentFolderIds", parentFolderId)
+	}
+	if extension := d.EqualsQualString("extension"); extension != "" {
+		params.Set("extension", extension)
+	}
+	if fileType := d.EqualsQualString("type"); fileType != "" {
+		params.Set("type", fileType)
+	}
+
+	for {
+		var response FilesResponse
+		err := getHubSpotApiResponse(ctx, d, "/files/v3/files/search", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_file.listFiles", "api_error", err)
+			return nil, err
+		}
+		for _, file := range response.Results {
+			d.StreamListItem(ctx, file)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFile(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	var file File
+	err := getHubSpotApiResponse(ctx, d, "/files/v3/files/"+url.PathEscape(id), nil, &file)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_file.getFile", "api_error", err)
+		return nil, err
+	}
+
+	return file, nil
+}
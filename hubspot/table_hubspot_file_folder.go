@@ -0,0 +1,165 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotFileFolder(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_file_folder",
+		Description: "List of HubSpot folders in the file manager.",
+		List: &plugin.ListConfig{
+			Hydrate: listFileFolders,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "parent_folder_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getFileFolder,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the folder.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the folder.",
+			},
+			{
+				Name:        "path",
+				Type:        proto.ColumnType_STRING,
+				Description: "The path of the folder in the file manager.",
+			},
+			{
+				Name:        "parent_folder_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the parent folder.",
+				Transform:   transform.FromField("ParentFolderId"),
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the folder is archived.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the folder was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the folder was last updated.",
+			},
+			{
+				Name:        "archived_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the folder was archived.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type FileFolder struct {
+	Id             string     `json:"id"`
+	Name           string     `json:"name"`
+	Path           string     `json:"path"`
+	ParentFolderId string     `json:"parentFolderId"`
+	Archived       bool       `json:"archived"`
+	CreatedAt      *time.Time `json:"createdAt"`
+	UpdatedAt      *time.Time `json:"updatedAt"`
+	ArchivedAt     *time.Time `json:"archivedAt"`
+}
+
+type FileFoldersResponse struct {
+	Results []FileFolder   `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listFileFolders(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	if parentFolderId := d.EqualsQualString("parent_folder_id"); parentFolderId != "" {
+		params.Set("parentFolderIds", parentFolderId)
+	}
+
+	for {
+		var response FileFoldersResponse
+		err := getHubSpotApiResponse(ctx, d, "/files/v3/folders/search", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_file_folder.listFileFolders", "api_error", err)
+			return nil, err
+		}
+		for _, folder := range response.Results {
+			d.StreamListItem(ctx, folder)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFileFolder(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	var folder FileFolder
+	err := getHubSpotApiResponse(ctx, d, "/files/v3/folders/"+url.PathEscape(id), nil, &folder)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_file_folder.getFileFolder", "api_error", err)
+		return nil, err
+	}
+
+	return folder, nil
+}
@@ -0,0 +1,192 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotForm(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_form",
+		Description: "List of HubSpot marketing Forms.",
+		List: &plugin.ListConfig{
+			Hydrate: listForms,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "form_type",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getForm,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the form.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the form.",
+			},
+			{
+				Name:        "form_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the form, e.g. hubspot, captured, flow, blog_comment.",
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the form is archived or not.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the form was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the form was last updated.",
+			},
+			{
+				Name:        "archived_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the form was archived.",
+			},
+			{
+				Name:        "field_groups",
+				Type:        proto.ColumnType_JSON,
+				Description: "The fields of the form, grouped in the order in which they are displayed.",
+			},
+			{
+				Name:        "configuration",
+				Type:        proto.ColumnType_JSON,
+				Description: "The configuration of the form, such as the language, the post submit action and the notification recipients.",
+			},
+			{
+				Name:        "display_options",
+				Type:        proto.ColumnType_JSON,
+				Description: "The options which control how the form is rendered.",
+			},
+			{
+				Name:        "legal_consent_options",
+				Type:        proto.ColumnType_JSON,
+				Description: "The legal consent options, such as the communication and processing consent checkboxes, shown on the form.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type Form struct {
+	Id                  string      `json:"id"`
+	Name                string      `json:"name"`
+	FormType            string      `json:"formType"`
+	Archived            bool        `json:"archived"`
+	CreatedAt           *time.Time  `json:"createdAt"`
+	UpdatedAt           *time.Time  `json:"updatedAt"`
+	ArchivedAt          *time.Time  `json:"archivedAt"`
+	FieldGroups         interface{} `json:"fieldGroups"`
+	Configuration       interface{} `json:"configuration"`
+	DisplayOptions      interface{} `json:"displayOptions"`
+	LegalConsentOptions interface{} `json:"legalConsentOptions"`
+}
+
+type FormsResponse struct {
+	Results []Form         `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listForms(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	archived := false
+
+	if d.EqualsQuals["archived"] != nil {
+		archived = d.EqualsQuals["archived"].GetBoolValue()
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	params.Set("archived", strconv.FormatBool(archived))
+	if d.EqualsQualString("form_type") != "" {
+		params.Set("formTypes", d.EqualsQualString("form_type"))
+	}
+
+	for {
+		var response FormsResponse
+		err := getHubSpotApiResponse(ctx, d, "/marketing/v3/forms", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_form.listForms", "api_error", err)
+			return nil, err
+		}
+		for _, form := range response.Results {
+			d.StreamListItem(ctx, form)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getForm(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	var form Form
+	err := getHubSpotApiResponse(ctx, d, "/marketing/v3/forms/"+url.PathEscape(id), nil, &form)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_form.getForm", "api_error", err)
+		return nil, err
+	}
+
+	return form, nil
+}
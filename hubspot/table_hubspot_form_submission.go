@@ -0,0 +1,118 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotFormSubmission(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_form_submission",
+		Description: "List of HubSpot Form submissions.",
+		List: &plugin.ListConfig{
+			Hydrate: listFormSubmissions,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "form_id",
+					Require: plugin.Required,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "form_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the form the submission belongs to.",
+				Transform:   transform.FromQual("form_id"),
+			},
+			{
+				Name:        "conversion_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the conversion created by the submission.",
+				Transform:   transform.FromField("ConversionId"),
+			},
+			{
+				Name:        "submitted_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the form was submitted.",
+				Transform:   transform.FromField("SubmittedAt").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "page_url",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URL of the page the form was submitted from.",
+			},
+			{
+				Name:        "values",
+				Type:        proto.ColumnType_JSON,
+				Description: "The list of submitted field names and values.",
+			},
+		}),
+	}
+}
+
+type FormSubmission struct {
+	ConversionId string      `json:"conversionId"`
+	SubmittedAt  int64       `json:"submittedAt"`
+	PageUrl      string      `json:"pageUrl"`
+	Values       interface{} `json:"values"`
+}
+
+type FormSubmissionsResponse struct {
+	Results []FormSubmission `json:"results"`
+	Paging  *hubSpotPaging   `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listFormSubmissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	formId := d.EqualsQualString("form_id")
+
+	// check if form_id is empty
+	if formId == "" {
+		return nil, nil
+	}
+
+	// Limiting the results, the submissions API allows a maximum of 50 results per page
+	var maxLimit int32 = 50
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+
+	for {
+		var response FormSubmissionsResponse
+		err := getHubSpotApiResponse(ctx, d, "/form-integrations/v1/submissions/forms/"+url.PathEscape(formId), params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_form_submission.listFormSubmissions", "api_error", err)
+			return nil, err
+		}
+		for _, submission := range response.Results {
+			d.StreamListItem(ctx, submission)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
@@ -2,6 +2,7 @@ package hubspot
 
 import (
 	"context"
+	"fmt"
 
 	hubspot "github.com/clarkmcc/go-hubspot"
 	"github.com/clarkmcc/go-hubspot/generated/v3/hubdb"
@@ -10,12 +11,17 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
+const (
+	hubDBVersionPublished = "published"
+	hubDBVersionDraft     = "draft"
+)
+
 //// TABLE DEFINITION
 
 func tableHubSpotHubDB(ctx context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "hubspot_hub_db",
-		Description: "List of HubSpot published HubDBs.",
+		Description: "List of HubSpot published or draft HubDBs.",
 		List: &plugin.ListConfig{
 			Hydrate: listHubDBs,
 			KeyColumns: []*plugin.KeyColumn{
@@ -23,11 +29,24 @@ func tableHubSpotHubDB(ctx context.Context) *plugin.Table {
 					Name:    "archived",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "version",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
-			Hydrate:    getHubDB,
-			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate: getHubDB,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "version",
+					Require: plugin.Optional,
+				},
+			},
 		},
 		Columns: commonColumns([]*plugin.Column{
 			{
@@ -121,6 +140,20 @@ func tableHubSpotHubDB(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Description: "Timestamp at which the table was recently updated.",
 			},
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "The version of the table, either published or draft. Defaults to published.",
+				Transform:   transform.FromQual("version"),
+				Default:     hubDBVersionPublished,
+			},
+			{
+				Name:        "has_unpublished_changes",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the draft version of the table has changes which have not been published yet.",
+				Hydrate:     getHubDBDraft,
+				Transform:   transform.From(hubDBHasUnpublishedChanges),
+			},
 
 			/// Steampipe standard columns
 			{
@@ -159,44 +192,42 @@ func listHubDBs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		archived = d.EqualsQuals["archived"].GetBoolValue()
 	}
 
-	for {
-		if after == "" {
-			response, _, err := client.TablesApi.GetAllTables(context).Limit(maxLimit).Archived(archived).Execute()
-			if err != nil {
-				plugin.Logger(ctx).Error("hubspot_hub_db.listHubDBs", "api_error", err)
-				return nil, err
-			}
-			for _, hubPost := range response.Results {
-				d.StreamListItem(ctx, hubPost)
+	version, err := getHubDBVersion(d)
+	if err != nil {
+		return nil, err
+	}
 
-				// Context can be cancelled due to manual cancellation or the limit has been hit
-				if d.RowsRemaining(ctx) == 0 {
-					return nil, nil
-				}
-			}
-			if !response.Paging.HasNext() {
-				break
+	for {
+		var response *hubdb.CollectionResponseWithTotalHubDbTableV3ForwardPaging
+		if version == hubDBVersionDraft {
+			request := client.TablesApi.GetAllDraftTables(context).Limit(maxLimit).Archived(archived)
+			if after != "" {
+				request = request.After(after)
 			}
-			after = response.Paging.Next.After
+			response, _, err = request.Execute()
 		} else {
-			response, _, err := client.TablesApi.GetAllTables(context).Limit(maxLimit).After(after).Archived(archived).Execute()
-			if err != nil {
-				plugin.Logger(ctx).Error("hubspot_hub_db.listHubDBs", "api_error", err)
-				return nil, err
+			request := client.TablesApi.GetAllTables(context).Limit(maxLimit).Archived(archived)
+			if after != "" {
+				request = request.After(after)
 			}
-			for _, hubPost := range response.Results {
-				d.StreamListItem(ctx, hubPost)
+			response, _, err = request.Execute()
+		}
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_hub_db.listHubDBs", "api_error", err)
+			return nil, err
+		}
+		for _, hubPost := range response.Results {
+			d.StreamListItem(ctx, hubPost)
 
-				// Context can be cancelled due to manual cancellation or the limit has been hit
-				if d.RowsRemaining(ctx) == 0 {
-					return nil, nil
-				}
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
 			}
-			if !response.Paging.HasNext() {
-				break
-			}
-			after = response.Paging.Next.After
 		}
+		if !response.Paging.HasNext() {
+			break
+		}
+		after = response.Paging.Next.After
 	}
 
 	return nil, nil
@@ -212,6 +243,11 @@ func getHubDB(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (
 		return nil, nil
 	}
 
+	version, err := getHubDBVersion(d)
+	if err != nil {
+		return nil, err
+	}
+
 	authorizer, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("hubspot_hub_db.getHubDB", "connection_error", err)
@@ -220,11 +256,81 @@ func getHubDB(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (
 	context := hubspot.WithAuthorizer(context.Background(), authorizer)
 	client := hubdb.NewAPIClient(hubdb.NewConfiguration())
 
-	hubPost, _, err := client.TablesApi.GetTableDetails(context, id).Execute()
+	var hubPost *hubdb.HubDbTableV3
+	if version == hubDBVersionDraft {
+		hubPost, _, err = client.TablesApi.GetDraftTableDetailsByID(context, id).Execute()
+	} else {
+		hubPost, _, err = client.TablesApi.GetTableDetails(context, id).Execute()
+	}
 	if err != nil {
 		plugin.Logger(ctx).Error("hubspot_hub_db.getHubDB", "api_error", err)
 		return nil, err
 	}
 
-	return hubPost, nil
+	return *hubPost, nil
+}
+
+// getHubDBVersion :: return the HubDB version requested in the quals, which defaults to published
+func getHubDBVersion(d *plugin.QueryData) (string, error) {
+	version := d.EqualsQualString("version")
+	switch version {
+	case "", hubDBVersionPublished:
+		return hubDBVersionPublished, nil
+	case hubDBVersionDraft:
+		return hubDBVersionDraft, nil
+	}
+
+	return "", fmt.Errorf("invalid version '%s', valid values are '%s' and '%s'", version, hubDBVersionPublished, hubDBVersionDraft)
+}
+
+func getHubDBDraft(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	table := h.Item.(hubdb.HubDbTableV3)
+
+	// check if id is empty
+	if table.GetId() == "" {
+		return nil, nil
+	}
+
+	// The draft version has already been fetched
+	version, err := getHubDBVersion(d)
+	if err != nil {
+		return nil, err
+	}
+	if version == hubDBVersionDraft {
+		return table, nil
+	}
+
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_hub_db.getHubDBDraft", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := hubdb.NewAPIClient(hubdb.NewConfiguration())
+
+	draft, _, err := client.TablesApi.GetDraftTableDetailsByID(context, table.GetId()).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_hub_db.getHubDBDraft", "api_error", err)
+		return nil, err
+	}
+
+	return *draft, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// hubDBHasUnpublishedChanges :: the draft of a table has unpublished changes if it was never published or was updated after it was last published
+func hubDBHasUnpublishedChanges(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	draft := d.HydrateItem.(hubdb.HubDbTableV3)
+	if draft.PublishedAt == nil {
+		return true, nil
+	}
+	if draft.UpdatedAt == nil {
+		return false, nil
+	}
+
+	return draft.UpdatedAt.After(*draft.PublishedAt), nil
 }
@@ -0,0 +1,197 @@
+package hubspot
+
+import (
+	"context"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/hubdb"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotHubDBColumn(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_hub_db_column",
+		Description: "List of the columns of HubSpot HubDB tables.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listHubDBColumnTables,
+			Hydrate:       listHubDBColumns,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "table_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "version",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "table_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the HubDB table the column belongs to.",
+				Transform:   transform.FromField("TableId"),
+			},
+			{
+				Name:        "table_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the HubDB table the column belongs to.",
+			},
+			{
+				Name:        "column_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the column.",
+				Transform:   transform.FromField("Column.Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the column.",
+				Transform:   transform.FromField("Column.Name"),
+			},
+			{
+				Name:        "label",
+				Type:        proto.ColumnType_STRING,
+				Description: "The label of the column.",
+				Transform:   transform.FromField("Column.Label"),
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the column, e.g. TEXT, NUMBER, SELECT, FOREIGN_ID.",
+				Transform:   transform.FromField("Column.Type"),
+			},
+			{
+				Name:        "width",
+				Type:        proto.ColumnType_INT,
+				Description: "The width of the column in the HubDB UI.",
+				Transform:   transform.FromField("Column.Width"),
+			},
+			{
+				Name:        "option_count",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of options of a select or multi-select column.",
+				Transform:   transform.FromField("Column.OptionCount"),
+			},
+			{
+				Name:        "options",
+				Type:        proto.ColumnType_JSON,
+				Description: "The options of a select or multi-select column.",
+				Transform:   transform.FromField("Column.Options"),
+			},
+			{
+				Name:        "foreign_table_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the HubDB table referenced by a foreign ID column.",
+				Transform:   transform.FromField("Column.ForeignTableId"),
+			},
+			{
+				Name:        "foreign_column_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the column referenced by a foreign ID column.",
+				Transform:   transform.FromField("Column.ForeignColumnId"),
+			},
+			{
+				Name:        "foreign_ids",
+				Type:        proto.ColumnType_JSON,
+				Description: "The foreign IDs of a foreign ID column.",
+				Transform:   transform.FromField("Column.ForeignIds"),
+			},
+			{
+				Name:        "deleted",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the column is deleted or not.",
+				Transform:   transform.FromField("Column.Archived"),
+				Default:     false,
+			},
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "The version of the table the column belongs to, either published or draft. Defaults to published.",
+				Transform:   transform.FromQual("version"),
+				Default:     hubDBVersionPublished,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Column.Label"),
+			},
+		}),
+	}
+}
+
+type HubDBColumn struct {
+	TableId   string
+	TableName string
+	Column    hubdb.Column
+}
+
+//// LIST FUNCTION
+
+// listHubDBColumnTables :: list the tables whose columns are listed, a requested table is fetched directly by its ID
+func listHubDBColumnTables(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	tableId := d.EqualsQualString("table_id")
+	if tableId == "" {
+		return listHubDBs(ctx, d, h)
+	}
+
+	version, err := getHubDBVersion(d)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_hub_db_column.listHubDBColumnTables", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := hubdb.NewAPIClient(hubdb.NewConfiguration())
+
+	var table *hubdb.HubDbTableV3
+	if version == hubDBVersionDraft {
+		table, _, err = client.TablesApi.GetDraftTableDetailsByID(context, tableId).Execute()
+	} else {
+		table, _, err = client.TablesApi.GetTableDetails(context, tableId).Execute()
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_hub_db_column.listHubDBColumnTables", "api_error", err)
+		return nil, err
+	}
+	d.StreamListItem(ctx, *table)
+
+	return nil, nil
+}
+
+func listHubDBColumns(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	table := h.Item.(hubdb.HubDbTableV3)
+	tableId := table.GetId()
+
+	// check if id is empty
+	if tableId == "" {
+		return nil, nil
+	}
+
+	for _, column := range table.Columns {
+		d.StreamListItem(ctx, HubDBColumn{
+			TableId:   tableId,
+			TableName: table.Name,
+			Column:    column,
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,409 @@
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/hubdb"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotHubDBRow(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_hub_db_row",
+		Description: "List of rows of a HubSpot HubDB table.",
+		List: &plugin.ListConfig{
+			Hydrate: listHubDBRows,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "table_id_or_name",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "path",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "values",
+					Require:   plugin.Optional,
+					Operators: []string{quals.QualOperatorJsonbContainsLeftRight, quals.QualOperatorJsonbPathPredicate},
+				},
+				{
+					Name:    "sort",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "version",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate: getHubDBRow,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "table_id_or_name",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "version",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "table_id_or_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID or name of the HubDB table the row belongs to.",
+				Transform:   transform.FromQual("table_id_or_name"),
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the row.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "path",
+				Type:        proto.ColumnType_STRING,
+				Description: "The value of the hs_path column, which is used as the slug of dynamic pages.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The value of the hs_name column, which is used as the title of dynamic pages.",
+			},
+			{
+				Name:        "child_table_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the child table of the row.",
+				Transform:   transform.FromField("ChildTableId"),
+			},
+			{
+				Name:        "values",
+				Type:        proto.ColumnType_JSON,
+				Description: "The values of the row, keyed by column name. Containment (@>) and simple JSON path predicate (@@) quals on this column are converted to HubDB filters.",
+			},
+			{
+				Name:        "sort",
+				Type:        proto.ColumnType_STRING,
+				Description: "The column to sort the rows by, in HubDB sort syntax, e.g. price or -price for descending order.",
+				Transform:   transform.FromQual("sort"),
+			},
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "The version of the table the row belongs to, either published or draft. Defaults to published.",
+				Transform:   transform.FromQual("version"),
+				Default:     hubDBVersionPublished,
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Timestamp at which the row was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Timestamp at which the row was last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(hubDBRowTitle),
+			},
+		}),
+	}
+}
+
+type HubDBRow struct {
+	Id           string                 `json:"id"`
+	Path         string                 `json:"path"`
+	Name         string                 `json:"name"`
+	ChildTableId string                 `json:"childTableId"`
+	Values       map[string]interface{} `json:"values"`
+	CreatedAt    *time.Time             `json:"createdAt"`
+	UpdatedAt    *time.Time             `json:"updatedAt"`
+}
+
+type HubDBRowsResponse struct {
+	Results []HubDBRow     `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listHubDBRows(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	tableIdOrName := d.EqualsQualString("table_id_or_name")
+
+	// check if table_id_or_name is empty
+	if tableIdOrName == "" {
+		return nil, nil
+	}
+
+	version, err := getHubDBVersion(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Limiting the results
+	var maxLimit int32 = 1000
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params, err := buildHubDBRowFilters(ctx, d, tableIdOrName, version)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_hub_db_row.listHubDBRows", "filter_error", err)
+		return nil, err
+	}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+
+	path := "/cms/v3/hubdb/tables/" + url.PathEscape(tableIdOrName) + "/rows"
+	if version == hubDBVersionDraft {
+		path = path + "/draft"
+	}
+	for {
+		var response HubDBRowsResponse
+		err := getHubSpotApiResponse(ctx, d, path, params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_hub_db_row.listHubDBRows", "api_error", err)
+			return nil, err
+		}
+		for _, row := range response.Results {
+			d.StreamListItem(ctx, row)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getHubDBRow(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	tableIdOrName := d.EqualsQualString("table_id_or_name")
+	id := d.EqualsQualString("id")
+
+	// check if table_id_or_name or id is empty
+	if tableIdOrName == "" || id == "" {
+		return nil, nil
+	}
+
+	version, err := getHubDBVersion(d)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/cms/v3/hubdb/tables/" + url.PathEscape(tableIdOrName) + "/rows/" + url.PathEscape(id)
+	if version == hubDBVersionDraft {
+		path = path + "/draft"
+	}
+
+	var row HubDBRow
+	err = getHubSpotApiResponse(ctx, d, path, nil, &row)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_hub_db_row.getHubDBRow", "api_error", err)
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// getHubDBTableColumns :: return the column definitions of the given version of a HubDB table, keyed by column name
+func getHubDBTableColumns(ctx context.Context, d *plugin.QueryData, tableIdOrName string, version string) (map[string]hubdb.Column, error) {
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := hubdb.NewAPIClient(hubdb.NewConfiguration())
+
+	var table *hubdb.HubDbTableV3
+	if version == hubDBVersionDraft {
+		table, _, err = client.TablesApi.GetDraftTableDetailsByID(context, tableIdOrName).Execute()
+	} else {
+		table, _, err = client.TablesApi.GetTableDetails(context, tableIdOrName).Execute()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]hubdb.Column{}
+	for _, column := range table.Columns {
+		columns[column.Name] = column
+	}
+
+	return columns, nil
+}
+
+//// FILTER FUNCTIONS
+
+// hubDBFilterableColumnTypes are the HubDB column types whose values can be compared with a scalar in a filter
+var hubDBFilterableColumnTypes = map[string]bool{
+	"TEXT":     true,
+	"NUMBER":   true,
+	"CURRENCY": true,
+	"BOOLEAN":  true,
+	"URL":      true,
+}
+
+// hubDBJsonPathOperators maps the JSON path comparison operators to the HubDB filter operators
+var hubDBJsonPathOperators = map[string]string{
+	"==": "eq",
+	"!=": "ne",
+	"<>": "ne",
+	">":  "gt",
+	">=": "gte",
+	"<":  "lt",
+	"<=": "lte",
+}
+
+// hubDBJsonPathPattern matches simple JSON path predicates such as $.price > 10, $.name like_regex "shoe" or $.name starts with "Red"
+var hubDBJsonPathPattern = regexp.MustCompile(`^\s*\$\.("?)([A-Za-z0-9_]+)("?)\s*(==|!=|<>|>=|<=|>|<|like_regex|starts with)\s*(.+?)\s*$`)
+
+// hubDBRegexMetaCharacters are the characters which make a like_regex pattern more than a plain substring match
+const hubDBRegexMetaCharacters = `\.+*?()|[]{}^$`
+
+// buildHubDBRowFilters :: convert the query quals to HubDB row filter and sort query parameters
+func buildHubDBRowFilters(ctx context.Context, d *plugin.QueryData, tableIdOrName string, version string) (url.Values, error) {
+	params := url.Values{}
+
+	if d.EqualsQualString("sort") != "" {
+		params.Set("sort", d.EqualsQualString("sort"))
+	}
+	if d.EqualsQualString("path") != "" {
+		params.Set("hs_path__eq", d.EqualsQualString("path"))
+	}
+	if d.EqualsQualString("name") != "" {
+		params.Set("hs_name__eq", d.EqualsQualString("name"))
+	}
+
+	if d.Quals["values"] == nil {
+		return params, nil
+	}
+
+	// The column definitions are used to only push down filters on existing columns which support them.
+	// Any qual which can't be converted is still applied by Steampipe on the returned rows.
+	columns, err := getHubDBTableColumns(ctx, d, tableIdOrName, version)
+	if err != nil {
+		return nil, err
+	}
+	canFilter := func(name string) bool {
+		column, ok := columns[name]
+		return ok && hubDBFilterableColumnTypes[column.Type]
+	}
+
+	for _, q := range d.Quals["values"].Quals {
+		switch q.Operator {
+		case quals.QualOperatorJsonbContainsLeftRight:
+			var values map[string]interface{}
+			if err := json.Unmarshal([]byte(q.Value.GetJsonbValue()), &values); err != nil {
+				plugin.Logger(ctx).Warn("hubspot_hub_db_row.buildHubDBRowFilters", "invalid_containment_value", q.Value.GetJsonbValue())
+				continue
+			}
+			for name, value := range values {
+				filterValue, ok := hubDBFilterValue(value)
+				if !ok || !canFilter(name) {
+					continue
+				}
+				params.Set(name+"__eq", filterValue)
+			}
+		case quals.QualOperatorJsonbPathPredicate:
+			predicate := q.Value.GetJsonbValue()
+			if predicate == "" {
+				predicate = q.Value.GetStringValue()
+			}
+			match := hubDBJsonPathPattern.FindStringSubmatch(predicate)
+			if match == nil || match[1] != match[3] || !canFilter(match[2]) {
+				continue
+			}
+			name, operator := match[2], match[4]
+
+			var value interface{}
+			if err := json.Unmarshal([]byte(match[5]), &value); err != nil {
+				continue
+			}
+			filterValue, ok := hubDBFilterValue(value)
+			if !ok {
+				continue
+			}
+
+			switch operator {
+			case "like_regex":
+				// Only plain substrings can be converted to a contains filter
+				if _, isString := value.(string); !isString || strings.ContainsAny(filterValue, hubDBRegexMetaCharacters) {
+					continue
+				}
+				params.Set(name+"__contains", filterValue)
+			case "starts with":
+				if _, isString := value.(string); !isString {
+					continue
+				}
+				params.Set(name+"__startswith", filterValue)
+			default:
+				params.Set(name+"__"+hubDBJsonPathOperators[operator], filterValue)
+			}
+		}
+	}
+
+	return params, nil
+}
+
+// hubDBFilterValue :: format a scalar JSON value as a HubDB filter value
+func hubDBFilterValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+//// TRANSFORM FUNCTIONS
+
+func hubDBRowTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	row := d.HydrateItem.(HubDBRow)
+	if row.Name != "" {
+		return row.Name, nil
+	}
+	return row.Id, nil
+}
@@ -0,0 +1,235 @@
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/imports"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotImport(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_import",
+		Description: "List of HubSpot CRM imports.",
+		List: &plugin.ListConfig{
+			Hydrate: listImports,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getImport,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the import.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "import_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the import.",
+			},
+			{
+				Name:        "state",
+				Type:        proto.ColumnType_STRING,
+				Description: "The state of the import, e.g. STARTED, PROCESSING, DONE, FAILED, CANCELED or DEFERRED.",
+			},
+			{
+				Name:        "import_source",
+				Type:        proto.ColumnType_STRING,
+				Description: "The source of the import, e.g. API, CRM_UI or IMPORT.",
+			},
+			{
+				Name:        "opt_out_import",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the import is a list of contacts opted out of receiving emails.",
+			},
+			{
+				Name:        "total_rows",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of rows in the import.",
+				Transform:   transform.FromField("Metadata.Counters.TOTAL_ROWS"),
+			},
+			{
+				Name:        "created_objects",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of records created by the import.",
+				Transform:   transform.FromField("Metadata.Counters.CREATED_OBJECTS"),
+			},
+			{
+				Name:        "updated_objects",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of records updated by the import.",
+				Transform:   transform.FromField("Metadata.Counters.UPDATED_OBJECTS"),
+			},
+			{
+				Name:        "errors",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of rows of the import which errored.",
+				Transform:   transform.FromField("Metadata.Counters.ERRORS"),
+			},
+			{
+				Name:        "counters",
+				Type:        proto.ColumnType_JSON,
+				Description: "The summarized outcomes of the rows of the import.",
+				Transform:   transform.FromField("Metadata.Counters"),
+			},
+			{
+				Name:        "mapped_object_type_ids",
+				Type:        proto.ColumnType_JSON,
+				Description: "The IDs of the object types the import maps to, e.g. 0-1 for contacts.",
+			},
+			{
+				Name:        "object_lists",
+				Type:        proto.ColumnType_JSON,
+				Description: "The lists containing the imported objects.",
+				Transform:   transform.FromField("Metadata.ObjectLists"),
+			},
+			{
+				Name:        "file_ids",
+				Type:        proto.ColumnType_JSON,
+				Description: "The IDs of the imported files in the file manager.",
+				Transform:   transform.FromField("Metadata.FileIds"),
+			},
+			{
+				Name:        "import_request_json",
+				Type:        proto.ColumnType_JSON,
+				Description: "The request which started the import, including the column mappings.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the import was started.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the import was last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ImportName"),
+			},
+		}),
+	}
+}
+
+// Import :: the generated model lacks the source and object types of the import, which are decoded from the response body
+type Import struct {
+	imports.PublicImportResponse
+	ImportExtras
+}
+
+type ImportExtras struct {
+	ImportSource        string   `json:"importSource"`
+	MappedObjectTypeIds []string `json:"mappedObjectTypeIds"`
+}
+
+//// LIST FUNCTION
+
+func listImports(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_import.listImports", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := imports.NewAPIClient(imports.NewConfiguration())
+
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	var after string = ""
+
+	for {
+		request := client.CoreApi.GetPage(context).Limit(maxLimit)
+		if after != "" {
+			request = request.After(after)
+		}
+		response, httpResponse, err := request.Execute()
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_import.listImports", "api_error", err)
+			return nil, err
+		}
+		var extras struct {
+			Results []ImportExtras `json:"results"`
+		}
+		if err := json.NewDecoder(httpResponse.Body).Decode(&extras); err != nil {
+			plugin.Logger(ctx).Error("hubspot_import.listImports", "decode_error", err)
+			return nil, err
+		}
+		for i, hubSpotImport := range response.Results {
+			item := Import{PublicImportResponse: hubSpotImport}
+			if i < len(extras.Results) {
+				item.ImportExtras = extras.Results[i]
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if !response.HasPaging() || response.Paging.Next == nil {
+			break
+		}
+		after = response.Paging.Next.After
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getImport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Import IDs are numeric, so other values cannot match an import
+	importId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_import.getImport", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := imports.NewAPIClient(imports.NewConfiguration())
+
+	hubSpotImport, httpResponse, err := client.CoreApi.GetByID(context, importId).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_import.getImport", "api_error", err)
+		return nil, err
+	}
+	item := Import{PublicImportResponse: *hubSpotImport}
+	if err := json.NewDecoder(httpResponse.Body).Decode(&item.ImportExtras); err != nil {
+		plugin.Logger(ctx).Error("hubspot_import.getImport", "decode_error", err)
+		return nil, err
+	}
+
+	return item, nil
+}
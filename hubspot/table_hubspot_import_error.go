@@ -0,0 +1,194 @@
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/imports"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotImportError(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_import_error",
+		Description: "List of the errors of a HubSpot CRM import.",
+		List: &plugin.ListConfig{
+			Hydrate:    listImportErrors,
+			KeyColumns: plugin.SingleColumn("import_id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the error.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "import_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the import the error belongs to.",
+				Transform:   transform.FromQual("import_id"),
+			},
+			{
+				Name:        "error_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the error, e.g. INVALID_EMAIL or UNKNOWN_ASSOCIATION_RECORD_ID.",
+			},
+			{
+				Name:        "object_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of object the errored row was imported into.",
+			},
+			{
+				Name:        "object_type_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the object type the errored row was imported into.",
+				Transform:   transform.FromField("ObjectTypeId"),
+			},
+			{
+				Name:        "invalid_value",
+				Type:        proto.ColumnType_STRING,
+				Description: "The value which caused the error.",
+			},
+			{
+				Name:        "extra_context",
+				Type:        proto.ColumnType_STRING,
+				Description: "Additional details about the error.",
+			},
+			{
+				Name:        "known_column_number",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of the column of the file which caused the error.",
+			},
+			{
+				Name:        "line_number",
+				Type:        proto.ColumnType_INT,
+				Description: "The line number of the errored row in the imported file.",
+				Transform:   transform.FromField("SourceData.LineNumber"),
+			},
+			{
+				Name:        "file_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the imported file containing the errored row.",
+				Transform:   transform.FromField("SourceData.FileId"),
+			},
+			{
+				Name:        "page_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the sheet containing the errored row, for spreadsheet imports.",
+				Transform:   transform.FromField("SourceData.PageName"),
+			},
+			{
+				Name:        "row_data",
+				Type:        proto.ColumnType_JSON,
+				Description: "The values of the errored row.",
+				Transform:   transform.FromField("SourceData.RowData"),
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the error occurred.",
+				Transform:   transform.FromField("CreatedAt").Transform(transform.UnixMsToTimestamp),
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ErrorType"),
+			},
+		}),
+	}
+}
+
+// ImportError :: the generated model declares createdAt as an int32, which cannot hold timestamps in milliseconds,
+// and lacks the row data, so the errors are decoded from the response body
+type ImportError struct {
+	imports.PublicImportError
+	SourceData ImportErrorSourceData `json:"sourceData"`
+	CreatedAt  int64                 `json:"createdAt"`
+}
+
+type ImportErrorSourceData struct {
+	imports.ImportRowCore
+	RowData []string `json:"rowData"`
+}
+
+type ImportErrorsResponse struct {
+	Results []ImportError  `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listImportErrors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("import_id")
+
+	// check if import_id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Import IDs are numeric, so other values cannot match an import
+	importId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_import_error.listImportErrors", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := imports.NewAPIClient(imports.NewConfiguration())
+
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	var after string = ""
+
+	for {
+		request := client.PublicImportsApi.GetErrors(context, importId).Limit(maxLimit)
+		if after != "" {
+			request = request.After(after)
+		}
+		// A successful response which the generated model fails to decode is decoded below
+		_, httpResponse, err := request.Execute()
+		if err != nil && (httpResponse == nil || httpResponse.StatusCode >= 300) {
+			plugin.Logger(ctx).Error("hubspot_import_error.listImportErrors", "api_error", err)
+			return nil, err
+		}
+		var response ImportErrorsResponse
+		if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+			plugin.Logger(ctx).Error("hubspot_import_error.listImportErrors", "decode_error", err)
+			return nil, err
+		}
+		for _, importError := range response.Results {
+			d.StreamListItem(ctx, importError)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after = response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+	}
+
+	return nil, nil
+}
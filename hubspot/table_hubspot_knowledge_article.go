@@ -0,0 +1,279 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const (
+	knowledgeArticleContentType = "KNOWLEDGE_ARTICLE"
+
+	// The site search API only returns published articles
+	knowledgeArticleStatePublished = "PUBLISHED"
+
+	// The indexed field holding the text of the article, suffixed with the language for translated articles
+	knowledgeArticleBodyField = "html_other"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotKnowledgeArticle(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_knowledge_article",
+		Description: "List of HubSpot published knowledge base articles.",
+		List: &plugin.ListConfig{
+			Hydrate: listKnowledgeArticles,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "query",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "language",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "domain",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the article.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: "The title of the article.",
+			},
+			{
+				Name:        "slug",
+				Type:        proto.ColumnType_STRING,
+				Description: "The path of the article.",
+				Transform:   transform.FromField("Url").Transform(knowledgeArticleSlug),
+			},
+			{
+				Name:        "url",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URL of the article.",
+			},
+			{
+				Name:        "domain",
+				Type:        proto.ColumnType_STRING,
+				Description: "The domain the article is hosted on.",
+			},
+			{
+				Name:        "category",
+				Type:        proto.ColumnType_STRING,
+				Description: "The category of the article.",
+			},
+			{
+				Name:        "subcategory",
+				Type:        proto.ColumnType_STRING,
+				Description: "The subcategory of the article.",
+			},
+			{
+				Name:        "language",
+				Type:        proto.ColumnType_STRING,
+				Description: "The language of the article.",
+			},
+			{
+				Name:        "state",
+				Type:        proto.ColumnType_STRING,
+				Description: "The state of the article. Always PUBLISHED, since only published articles are returned by the site search API.",
+				Transform:   transform.FromConstant(knowledgeArticleStatePublished),
+			},
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "The description of the article.",
+			},
+			{
+				Name:        "body",
+				Type:        proto.ColumnType_STRING,
+				Description: "The text of the body of the article.",
+				Hydrate:     getKnowledgeArticleIndexedData,
+				Transform:   transform.FromField("Fields").Transform(knowledgeArticleBody),
+			},
+			{
+				Name:        "author_full_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the author of the article.",
+			},
+			{
+				Name:        "tags",
+				Type:        proto.ColumnType_JSON,
+				Description: "The tags of the article.",
+			},
+			{
+				Name:        "published_date",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the article was published.",
+				Transform:   transform.FromField("PublishedDate").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "query",
+				Type:        proto.ColumnType_STRING,
+				Description: "The term used to search the articles.",
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "score",
+				Type:        proto.ColumnType_DOUBLE,
+				Description: "The relevance of the article to the search term.",
+			},
+			{
+				Name:        "indexed_fields",
+				Type:        proto.ColumnType_JSON,
+				Description: "The indexed fields of the article, including its body.",
+				Hydrate:     getKnowledgeArticleIndexedData,
+				Transform:   transform.FromField("Fields"),
+			},
+		}),
+	}
+}
+
+type KnowledgeArticle struct {
+	Id             int64    `json:"id"`
+	Title          string   `json:"title"`
+	Url            string   `json:"url"`
+	Domain         string   `json:"domain"`
+	Category       string   `json:"category"`
+	Subcategory    string   `json:"subcategory"`
+	Language       string   `json:"language"`
+	Description    string   `json:"description"`
+	AuthorFullName string   `json:"authorFullName"`
+	Tags           []string `json:"tags"`
+	PublishedDate  int64    `json:"publishedDate"`
+	Score          float64  `json:"score"`
+}
+
+type KnowledgeArticlesResponse struct {
+	Total   int                `json:"total"`
+	Offset  int                `json:"offset"`
+	Results []KnowledgeArticle `json:"results"`
+}
+
+type KnowledgeArticleIndexedData struct {
+	Id     string                 `json:"id"`
+	Type   string                 `json:"type"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+//// LIST FUNCTION
+
+func listKnowledgeArticles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	// There is no knowledge base API, the published articles are listed through the site search API
+	params := url.Values{}
+	params.Set("type", knowledgeArticleContentType)
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	if query := d.EqualsQualString("query"); query != "" {
+		params.Set("q", query)
+	}
+	if language := d.EqualsQualString("language"); language != "" {
+		params.Set("language", language)
+	}
+	if domain := d.EqualsQualString("domain"); domain != "" {
+		params.Set("domain", domain)
+	}
+
+	offset := 0
+	for {
+		params.Set("offset", strconv.Itoa(offset))
+
+		var response KnowledgeArticlesResponse
+		err := getHubSpotApiResponse(ctx, d, "/cms/v3/site-search/search", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_knowledge_article.listKnowledgeArticles", "api_error", err)
+			return nil, err
+		}
+		for _, article := range response.Results {
+			d.StreamListItem(ctx, article)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		offset += len(response.Results)
+		if len(response.Results) == 0 || offset >= response.Total {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getKnowledgeArticleIndexedData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	article := h.Item.(KnowledgeArticle)
+
+	params := url.Values{}
+	params.Set("type", knowledgeArticleContentType)
+
+	var indexedData KnowledgeArticleIndexedData
+	err := getHubSpotApiResponse(ctx, d, "/cms/v3/site-search/indexed-data/"+strconv.FormatInt(article.Id, 10), params, &indexedData)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_knowledge_article.getKnowledgeArticleIndexedData", "api_error", err)
+		return nil, err
+	}
+
+	return indexedData, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func knowledgeArticleSlug(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	articleUrl, ok := d.Value.(string)
+	if !ok || articleUrl == "" {
+		return nil, nil
+	}
+	parsed, err := url.Parse(articleUrl)
+	if err != nil {
+		return nil, nil
+	}
+	return strings.TrimPrefix(parsed.Path, "/"), nil
+}
+
+// knowledgeArticleBody :: return the value of the indexed body field, which is suffixed with the language of translated articles
+func knowledgeArticleBody(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	fields, ok := d.Value.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	field, ok := fields[knowledgeArticleBodyField]
+	if !ok {
+		for name, value := range fields {
+			if strings.HasPrefix(name, knowledgeArticleBodyField+"_") {
+				field = value
+				break
+			}
+		}
+	}
+
+	// Each indexed field is returned as an object holding its value
+	if field, ok := field.(map[string]interface{}); ok {
+		return field["value"], nil
+	}
+	return field, nil
+}
@@ -0,0 +1,61 @@
+package hubspot
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotLandingPage(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_landing_page",
+		Description: "List of HubSpot Landing Pages.",
+		List: &plugin.ListConfig{
+			Hydrate: listLandingPages,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getLandingPage,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns(cmsPageColumns("landing page")),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLandingPages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	err := listCMSPages(ctx, d, "/cms/v3/pages/landing-pages")
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_landing_page.listLandingPages", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLandingPage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	page, err := getCMSPage(ctx, d, "/cms/v3/pages/landing-pages", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_landing_page.getLandingPage", "api_error", err)
+		return nil, err
+	}
+
+	return *page, nil
+}
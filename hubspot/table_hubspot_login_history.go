@@ -0,0 +1,143 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotLoginHistory(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_login_history",
+		Description: "List of HubSpot account user logins.",
+		List: &plugin.ListConfig{
+			Hydrate: listLoginHistory,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "user_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the login.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "user_id",
+				Type:        proto.ColumnType_INT,
+				Description: "The ID of the user who logged in.",
+				Transform:   transform.FromField("UserId"),
+			},
+			{
+				Name:        "email",
+				Type:        proto.ColumnType_STRING,
+				Description: "The email address of the user who logged in.",
+			},
+			{
+				Name:        "occurred_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp of the login.",
+				Transform:   transform.FromField("LoginAt"),
+			},
+			{
+				Name:        "login_succeeded",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the login succeeded or not.",
+				Transform:   transform.FromField("LoginSucceeded"),
+			},
+			{
+				Name:        "ip_address",
+				Type:        proto.ColumnType_IPADDR,
+				Description: "The IP address the login was made from.",
+			},
+			{
+				Name:        "location",
+				Type:        proto.ColumnType_STRING,
+				Description: "The location the login was made from.",
+			},
+			{
+				Name:        "user_agent",
+				Type:        proto.ColumnType_STRING,
+				Description: "The user agent of the login.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type Login struct {
+	Id             string     `json:"id"`
+	UserId         string     `json:"userId"`
+	Email          string     `json:"email"`
+	LoginAt        *time.Time `json:"loginAt"`
+	LoginSucceeded bool       `json:"loginSucceeded"`
+	IpAddress      string     `json:"ipAddress"`
+	Location       string     `json:"location"`
+	UserAgent      string     `json:"userAgent"`
+}
+
+type LoginHistoryResponse struct {
+	Results []Login        `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listLoginHistory(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	if d.EqualsQuals["user_id"] != nil {
+		params.Set("userId", strconv.FormatInt(d.EqualsQuals["user_id"].GetInt64Value(), 10))
+	}
+
+	for {
+		var response LoginHistoryResponse
+		err := getHubSpotApiResponse(ctx, d, "/account-info/v3/activity/login", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_login_history.listLoginHistory", "api_error", err)
+			return nil, err
+		}
+		for _, login := range response.Results {
+			d.StreamListItem(ctx, login)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
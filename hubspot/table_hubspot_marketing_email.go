@@ -0,0 +1,308 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotMarketingEmail(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_marketing_email",
+		Description: "List of HubSpot Marketing Emails.",
+		List: &plugin.ListConfig{
+			Hydrate: listMarketingEmails,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getMarketingEmail,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the marketing email.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The internal name of the marketing email.",
+			},
+			{
+				Name:        "subject",
+				Type:        proto.ColumnType_STRING,
+				Description: "The subject line of the marketing email.",
+			},
+			{
+				Name:        "state",
+				Type:        proto.ColumnType_STRING,
+				Description: "The state of the marketing email, e.g. DRAFT, SCHEDULED, PUBLISHED.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the marketing email, e.g. BATCH_EMAIL, AB_EMAIL, AUTOMATED_EMAIL.",
+			},
+			{
+				Name:        "published",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the marketing email is published or not.",
+				Transform:   transform.FromField("IsPublished"),
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the marketing email is archived or not.",
+			},
+			{
+				Name:        "publish_date",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The date and time the marketing email is scheduled to be or was published.",
+			},
+			{
+				Name:        "from_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name the marketing email is sent from.",
+				Transform:   transform.FromField("From.FromName"),
+			},
+			{
+				Name:        "reply_to",
+				Type:        proto.ColumnType_STRING,
+				Description: "The reply to address of the marketing email.",
+				Transform:   transform.FromField("From.ReplyTo"),
+			},
+			{
+				Name:        "custom_reply_to",
+				Type:        proto.ColumnType_STRING,
+				Description: "The custom reply to address of the marketing email.",
+				Transform:   transform.FromField("From.CustomReplyTo"),
+			},
+			{
+				Name:        "campaign",
+				Type:        proto.ColumnType_STRING,
+				Description: "The GUID of the marketing campaign the email is a part of.",
+			},
+			{
+				Name:        "campaign_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the marketing campaign the email is a part of.",
+			},
+			{
+				Name:        "sent",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of times the marketing email was sent.",
+				Transform:   transform.FromField("Stats.Counters.Sent"),
+			},
+			{
+				Name:        "delivered",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of times the marketing email was delivered.",
+				Transform:   transform.FromField("Stats.Counters.Delivered"),
+			},
+			{
+				Name:        "opened",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of times the marketing email was opened.",
+				Transform:   transform.FromField("Stats.Counters.Open"),
+			},
+			{
+				Name:        "clicked",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of times a link in the marketing email was clicked.",
+				Transform:   transform.FromField("Stats.Counters.Click"),
+			},
+			{
+				Name:        "bounced",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of times the marketing email bounced.",
+				Transform:   transform.FromField("Stats.Counters.Bounce"),
+			},
+			{
+				Name:        "unsubscribed",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of recipients who unsubscribed from the marketing email.",
+				Transform:   transform.FromField("Stats.Counters.Unsubscribed"),
+			},
+			{
+				Name:        "spam_reports",
+				Type:        proto.ColumnType_INT,
+				Description: "The number of recipients who reported the marketing email as spam.",
+				Transform:   transform.FromField("Stats.Counters.SpamReport"),
+			},
+			{
+				Name:        "stats",
+				Type:        proto.ColumnType_JSON,
+				Description: "The aggregate statistics of the marketing email, including the counters and ratios.",
+			},
+			{
+				Name:        "to",
+				Type:        proto.ColumnType_JSON,
+				Description: "The contact lists and ILS lists the marketing email is sent to or excluded from.",
+			},
+			{
+				Name:        "language",
+				Type:        proto.ColumnType_STRING,
+				Description: "The language of the marketing email.",
+			},
+			{
+				Name:        "created_by_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the user that created the marketing email.",
+				Transform:   transform.FromField("CreatedById"),
+			},
+			{
+				Name:        "updated_by_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the user that last updated the marketing email.",
+				Transform:   transform.FromField("UpdatedById"),
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the marketing email was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the marketing email was last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type MarketingEmail struct {
+	Id           string               `json:"id"`
+	Name         string               `json:"name"`
+	Subject      string               `json:"subject"`
+	State        string               `json:"state"`
+	Type         string               `json:"type"`
+	IsPublished  bool                 `json:"isPublished"`
+	Archived     bool                 `json:"archived"`
+	PublishDate  *time.Time           `json:"publishDate"`
+	From         *MarketingEmailFrom  `json:"from"`
+	Campaign     string               `json:"campaign"`
+	CampaignName string               `json:"campaignName"`
+	Stats        *MarketingEmailStats `json:"stats"`
+	To           interface{}          `json:"to"`
+	Language     string               `json:"language"`
+	CreatedById  string               `json:"createdById"`
+	UpdatedById  string               `json:"updatedById"`
+	CreatedAt    *time.Time           `json:"createdAt"`
+	UpdatedAt    *time.Time           `json:"updatedAt"`
+}
+
+type MarketingEmailFrom struct {
+	FromName      string `json:"fromName"`
+	ReplyTo       string `json:"replyTo"`
+	CustomReplyTo string `json:"customReplyTo"`
+}
+
+type MarketingEmailStats struct {
+	Counters struct {
+		Sent         int64 `json:"sent"`
+		Delivered    int64 `json:"delivered"`
+		Open         int64 `json:"open"`
+		Click        int64 `json:"click"`
+		Bounce       int64 `json:"bounce"`
+		Unsubscribed int64 `json:"unsubscribed"`
+		SpamReport   int64 `json:"spamreport"`
+	} `json:"counters"`
+	Ratios map[string]float64 `json:"ratios"`
+}
+
+type MarketingEmailsResponse struct {
+	Results []MarketingEmail `json:"results"`
+	Paging  *hubSpotPaging   `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listMarketingEmails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	archived := false
+
+	if d.EqualsQuals["archived"] != nil {
+		archived = d.EqualsQuals["archived"].GetBoolValue()
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	params.Set("archived", strconv.FormatBool(archived))
+	params.Set("includeStats", "true")
+
+	for {
+		var response MarketingEmailsResponse
+		err := getHubSpotApiResponse(ctx, d, "/marketing/v3/emails", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_marketing_email.listMarketingEmails", "api_error", err)
+			return nil, err
+		}
+		for _, email := range response.Results {
+			d.StreamListItem(ctx, email)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMarketingEmail(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	params := url.Values{}
+	params.Set("includeStats", "true")
+
+	var email MarketingEmail
+	err := getHubSpotApiResponse(ctx, d, "/marketing/v3/emails/"+url.PathEscape(id), params, &email)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_marketing_email.getMarketingEmail", "api_error", err)
+		return nil, err
+	}
+
+	return email, nil
+}
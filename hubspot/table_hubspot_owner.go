@@ -24,6 +24,14 @@ func tableHubSpotOwner(ctx context.Context) *plugin.Table {
 					Name:    "archived",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "email",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "user_id",
+					Require: plugin.Optional,
+				},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -116,44 +124,46 @@ func listOwners(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		archived = d.EqualsQuals["archived"].GetBoolValue()
 	}
 
-	for {
-		if after == "" {
-			response, _, err := client.OwnersApi.GetPage(context).Limit(maxLimit).Archived(archived).Execute()
-			if err != nil {
-				plugin.Logger(ctx).Error("hubspot_owner.listOwners", "api_error", err)
-				return nil, err
-			}
-			for _, owner := range response.Results {
-				d.StreamListItem(ctx, owner)
+	// The owner of a user is fetched directly by its user ID
+	if d.EqualsQuals["user_id"] != nil {
+		userId := d.EqualsQuals["user_id"].GetInt64Value()
+		owner, _, err := client.OwnersApi.GetByID(context, int32(userId)).IdProperty("userId").Archived(archived).Execute()
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_owner.listOwners", "api_error", err)
+			return nil, err
+		}
+		d.StreamListItem(ctx, *owner)
 
-				// Context can be cancelled due to manual cancellation or the limit has been hit
-				if d.RowsRemaining(ctx) == 0 {
-					return nil, nil
-				}
-			}
-			if !response.Paging.HasNext() {
-				break
-			}
-			after = response.Paging.Next.After
-		} else {
-			response, _, err := client.OwnersApi.GetPage(context).Limit(maxLimit).After(after).Archived(archived).Execute()
-			if err != nil {
-				plugin.Logger(ctx).Error("hubspot_owner.listOwners", "api_error", err)
-				return nil, err
-			}
-			for _, owner := range response.Results {
-				d.StreamListItem(ctx, owner)
+		return nil, nil
+	}
 
-				// Context can be cancelled due to manual cancellation or the limit has been hit
-				if d.RowsRemaining(ctx) == 0 {
-					return nil, nil
-				}
-			}
-			if !response.Paging.HasNext() {
-				break
+	email := d.EqualsQualString("email")
+
+	for {
+		request := client.OwnersApi.GetPage(context).Limit(maxLimit).Archived(archived)
+		if email != "" {
+			request = request.Email(email)
+		}
+		if after != "" {
+			request = request.After(after)
+		}
+		response, _, err := request.Execute()
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_owner.listOwners", "api_error", err)
+			return nil, err
+		}
+		for _, owner := range response.Results {
+			d.StreamListItem(ctx, owner)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
 			}
-			after = response.Paging.Next.After
 		}
+		if !response.Paging.HasNext() {
+			break
+		}
+		after = response.Paging.Next.After
 	}
 
 	return nil, nil
@@ -163,6 +173,12 @@ func listOwners(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 
 func getOwner(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
 	ownerId, err := strconv.Atoi(id)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,176 @@
+package hubspot
+
+import (
+	"context"
+	"strconv"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/owners"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotOwnerTeam(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_owner_team",
+		Description: "List of the team memberships of HubSpot Owners.",
+		List: &plugin.ListConfig{
+			Hydrate: listOwnerTeams,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "owner_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "owner_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the owner.",
+				Transform:   transform.FromField("OwnerId"),
+			},
+			{
+				Name:        "owner_email",
+				Type:        proto.ColumnType_STRING,
+				Description: "The email address of the owner.",
+			},
+			{
+				Name:        "owner_user_id",
+				Type:        proto.ColumnType_INT,
+				Description: "The user ID associated with the owner.",
+				Transform:   transform.FromField("OwnerUserId"),
+			},
+			{
+				Name:        "team_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the team.",
+				Transform:   transform.FromField("TeamId"),
+			},
+			{
+				Name:        "team_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the team.",
+			},
+			{
+				Name:        "is_primary",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the team is the primary team of the owner.",
+				Transform:   transform.FromField("IsPrimary"),
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the owner is archived or not.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TeamName"),
+			},
+		}),
+	}
+}
+
+type OwnerTeam struct {
+	OwnerId     string
+	OwnerEmail  *string
+	OwnerUserId *int32
+	TeamId      string
+	TeamName    string
+	IsPrimary   bool
+	Archived    bool
+}
+
+//// LIST FUNCTION
+
+func listOwnerTeams(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_owner_team.listOwnerTeams", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := owners.NewAPIClient(owners.NewConfiguration())
+
+	// An owner belongs to any number of teams, so the query limit can not be applied to the page size
+	var maxLimit int32 = 100
+	var after string = ""
+	archived := false
+
+	if d.EqualsQuals["archived"] != nil {
+		archived = d.EqualsQuals["archived"].GetBoolValue()
+	}
+
+	// The teams of a single owner are fetched directly by its ID
+	ownerId := d.EqualsQualString("owner_id")
+	if ownerId != "" {
+		// Owner IDs are numeric, so other values cannot match an owner
+		id, err := strconv.ParseInt(ownerId, 10, 32)
+		if err != nil {
+			return nil, nil
+		}
+		owner, _, err := client.OwnersApi.GetByID(context, int32(id)).IdProperty("id").Archived(archived).Execute()
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_owner_team.listOwnerTeams", "api_error", err)
+			return nil, err
+		}
+		streamOwnerTeams(ctx, d, *owner)
+
+		return nil, nil
+	}
+
+	for {
+		request := client.OwnersApi.GetPage(context).Limit(maxLimit).Archived(archived)
+		if after != "" {
+			request = request.After(after)
+		}
+		response, _, err := request.Execute()
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_owner_team.listOwnerTeams", "api_error", err)
+			return nil, err
+		}
+		for _, owner := range response.Results {
+			if !streamOwnerTeams(ctx, d, owner) {
+				return nil, nil
+			}
+		}
+		if !response.Paging.HasNext() {
+			break
+		}
+		after = response.Paging.Next.After
+	}
+
+	return nil, nil
+}
+
+// streamOwnerTeams :: stream a row per team of the owner, returning false once no more rows are needed
+func streamOwnerTeams(ctx context.Context, d *plugin.QueryData, owner owners.PublicOwner) bool {
+	for _, team := range owner.Teams {
+		d.StreamListItem(ctx, OwnerTeam{
+			OwnerId:     owner.Id,
+			OwnerEmail:  owner.Email,
+			OwnerUserId: owner.UserId,
+			TeamId:      team.Id,
+			TeamName:    team.Name,
+			IsPrimary:   team.Primary,
+			Archived:    owner.Archived,
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return false
+		}
+	}
+	return true
+}
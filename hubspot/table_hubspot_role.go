@@ -0,0 +1,79 @@
+package hubspot
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotRole(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_role",
+		Description: "List of HubSpot permission Roles.",
+		List: &plugin.ListConfig{
+			Hydrate: listRoles,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the role.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the role.",
+			},
+			{
+				Name:        "requires_billing_write",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether assigning the role requires the billing write permission.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type Role struct {
+	Id                   string `json:"id"`
+	Name                 string `json:"name"`
+	RequiresBillingWrite bool   `json:"requiresBillingWrite"`
+}
+
+type RolesResponse struct {
+	Results []Role `json:"results"`
+}
+
+//// LIST FUNCTION
+
+func listRoles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// The roles API does not support paging, all the roles are returned in a single response
+	var response RolesResponse
+	err := getHubSpotApiResponse(ctx, d, "/settings/v3/users/roles", nil, &response)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_role.listRoles", "api_error", err)
+		return nil, err
+	}
+	for _, role := range response.Results {
+		d.StreamListItem(ctx, role)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,169 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotSecurityActivity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_security_activity",
+		Description: "List of HubSpot account security activities.",
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityActivities,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:      "occurred_at",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
+				{
+					Name:    "user_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the security activity.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the security activity, e.g. EXPORT, PERMISSIONS_CHANGE, PRIVATE_APP_TOKEN_VIEW.",
+			},
+			{
+				Name:        "user_id",
+				Type:        proto.ColumnType_INT,
+				Description: "The ID of the user the activity relates to.",
+				Transform:   transform.FromField("UserId"),
+			},
+			{
+				Name:        "acting_user",
+				Type:        proto.ColumnType_STRING,
+				Description: "The user who performed the activity.",
+			},
+			{
+				Name:        "object_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the object the activity relates to.",
+				Transform:   transform.FromField("ObjectId"),
+			},
+			{
+				Name:        "info_url",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URL of the page with more details of the activity.",
+			},
+			{
+				Name:        "occurred_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the activity occurred.",
+				Transform:   transform.FromField("CreatedAt"),
+			},
+			{
+				Name:        "ip_address",
+				Type:        proto.ColumnType_IPADDR,
+				Description: "The IP address the activity was performed from.",
+			},
+			{
+				Name:        "location",
+				Type:        proto.ColumnType_STRING,
+				Description: "The location the activity was performed from.",
+			},
+			{
+				Name:        "user_agent",
+				Type:        proto.ColumnType_STRING,
+				Description: "The user agent the activity was performed with.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type SecurityActivity struct {
+	Id         string     `json:"id"`
+	Type       string     `json:"type"`
+	UserId     string     `json:"userId"`
+	ActingUser string     `json:"actingUser"`
+	ObjectId   string     `json:"objectId"`
+	InfoUrl    string     `json:"infoUrl"`
+	CreatedAt  *time.Time `json:"createdAt"`
+	IpAddress  string     `json:"ipAddress"`
+	Location   string     `json:"location"`
+	UserAgent  string     `json:"userAgent"`
+}
+
+type SecurityActivitiesResponse struct {
+	Results []SecurityActivity `json:"results"`
+	Paging  *hubSpotPaging     `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listSecurityActivities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+	if d.EqualsQuals["user_id"] != nil {
+		params.Set("userId", strconv.FormatInt(d.EqualsQuals["user_id"].GetInt64Value(), 10))
+	}
+
+	// The API filters on the time range in which the activities occurred, in epoch milliseconds
+	start, end := getTimeRangeFromQuals(d, "occurred_at")
+	if start != nil {
+		params.Set("fromTimestamp", strconv.FormatInt(start.UnixMilli(), 10))
+	}
+	if end != nil {
+		params.Set("toTimestamp", strconv.FormatInt(end.UnixMilli(), 10))
+	}
+
+	for {
+		var response SecurityActivitiesResponse
+		err := getHubSpotApiResponse(ctx, d, "/account-info/v3/activity/security", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_security_activity.listSecurityActivities", "api_error", err)
+			return nil, err
+		}
+		for _, activity := range response.Results {
+			d.StreamListItem(ctx, activity)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,61 @@
+package hubspot
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotSitePage(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_site_page",
+		Description: "List of HubSpot Site Pages.",
+		List: &plugin.ListConfig{
+			Hydrate: listSitePages,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getSitePage,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns(cmsPageColumns("site page")),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSitePages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	err := listCMSPages(ctx, d, "/cms/v3/pages/site-pages")
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_site_page.listSitePages", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSitePage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	page, err := getCMSPage(ctx, d, "/cms/v3/pages/site-pages", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_site_page.getSitePage", "api_error", err)
+		return nil, err
+	}
+
+	return *page, nil
+}
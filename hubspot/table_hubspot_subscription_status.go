@@ -0,0 +1,121 @@
+package hubspot
+
+import (
+	"context"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/communications_status"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotSubscriptionStatus(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_subscription_status",
+		Description: "List of HubSpot email subscription statuses of a contact.",
+		List: &plugin.ListConfig{
+			Hydrate:    listSubscriptionStatuses,
+			KeyColumns: plugin.SingleColumn("email"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "email",
+				Type:        proto.ColumnType_STRING,
+				Description: "The email address of the contact.",
+				Transform:   transform.FromQual("email"),
+			},
+			{
+				Name:        "subscription_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the subscription type.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the subscription type.",
+			},
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "A description of the subscription type.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Whether the contact is subscribed to the subscription type: SUBSCRIBED, NOT_SUBSCRIBED.",
+			},
+			{
+				Name:        "source_of_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Where the status is determined from, e.g. PORTAL_WIDE_STATUS if the contact opted out from all email communication.",
+			},
+			{
+				Name:        "legal_basis",
+				Type:        proto.ColumnType_STRING,
+				Description: "The legal reason for the current status of the subscription, e.g. CONSENT_WITH_NOTICE, LEGITIMATE_INTEREST_CLIENT.",
+			},
+			{
+				Name:        "legal_basis_explanation",
+				Type:        proto.ColumnType_STRING,
+				Description: "A more detailed explanation of the legal basis.",
+			},
+			{
+				Name:        "brand_id",
+				Type:        proto.ColumnType_INT,
+				Description: "The ID of the brand the subscription type is associated with, if there is one.",
+			},
+			{
+				Name:        "preference_group_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the preference group the subscription type is associated with.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSubscriptionStatuses(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	email := d.EqualsQualString("email")
+
+	// check if email is empty
+	if email == "" {
+		return nil, nil
+	}
+
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_subscription_status.listSubscriptionStatuses", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := communications_status.NewAPIClient(communications_status.NewConfiguration())
+
+	response, _, err := client.StatusApi.GetEmailStatus(context, email).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_subscription_status.listSubscriptionStatuses", "api_error", err)
+		return nil, err
+	}
+	for _, status := range response.SubscriptionStatuses {
+		d.StreamListItem(ctx, status)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,113 @@
+package hubspot
+
+import (
+	"context"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/communications_status"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotSubscriptionType(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_subscription_type",
+		Description: "List of HubSpot email subscription types.",
+		List: &plugin.ListConfig{
+			Hydrate: listSubscriptionTypes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the subscription type.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the subscription type.",
+			},
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "A description of the subscription type.",
+			},
+			{
+				Name:        "purpose",
+				Type:        proto.ColumnType_STRING,
+				Description: "The purpose of the subscription type or the department in your organization that uses it.",
+			},
+			{
+				Name:        "communication_method",
+				Type:        proto.ColumnType_STRING,
+				Description: "The method or technology used to contact, e.g. Email.",
+			},
+			{
+				Name:        "is_active",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the subscription type is active or archived.",
+			},
+			{
+				Name:        "is_default",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the subscription type was created by HubSpot.",
+			},
+			{
+				Name:        "is_internal",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the subscription type is used by HubSpot tools and cannot be edited.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the subscription type was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the subscription type was last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSubscriptionTypes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_subscription_type.listSubscriptionTypes", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := communications_status.NewAPIClient(communications_status.NewConfiguration())
+
+	// The API returns all the subscription types of the portal in a single response
+	response, _, err := client.DefinitionApi.GetPage(context).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_subscription_type.listSubscriptionTypes", "api_error", err)
+		return nil, err
+	}
+	for _, subscriptionType := range response.SubscriptionDefinitions {
+		d.StreamListItem(ctx, subscriptionType)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,87 @@
+package hubspot
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotTeam(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_team",
+		Description: "List of HubSpot Teams.",
+		List: &plugin.ListConfig{
+			Hydrate: listTeams,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the team.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the team.",
+			},
+			{
+				Name:        "user_ids",
+				Type:        proto.ColumnType_JSON,
+				Description: "The IDs of the users for whom this is the primary team.",
+				Transform:   transform.FromField("UserIds"),
+			},
+			{
+				Name:        "secondary_user_ids",
+				Type:        proto.ColumnType_JSON,
+				Description: "The IDs of the users for whom this is a secondary team.",
+				Transform:   transform.FromField("SecondaryUserIds"),
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type Team struct {
+	Id               string   `json:"id"`
+	Name             string   `json:"name"`
+	UserIds          []string `json:"userIds"`
+	SecondaryUserIds []string `json:"secondaryUserIds"`
+}
+
+type TeamsResponse struct {
+	Results []Team `json:"results"`
+}
+
+//// LIST FUNCTION
+
+func listTeams(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// The teams API does not support paging, all the teams are returned in a single response
+	var response TeamsResponse
+	err := getHubSpotApiResponse(ctx, d, "/settings/v3/users/teams", nil, &response)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_team.listTeams", "api_error", err)
+		return nil, err
+	}
+	for _, team := range response.Results {
+		d.StreamListItem(ctx, team)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
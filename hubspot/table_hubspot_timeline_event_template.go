@@ -0,0 +1,142 @@
+package hubspot
+
+import (
+	"context"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/timeline"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotTimelineEventTemplate(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_timeline_event_template",
+		Description: "List of HubSpot timeline event templates of the configured app.",
+		List: &plugin.ListConfig{
+			Hydrate: listTimelineEventTemplates,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getTimelineEventTemplate,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		// App level resources belong to the developer account rather than a portal, so there is no portal_id column
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the template.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "app_id",
+				Type:        proto.ColumnType_INT,
+				Description: "The ID of the app the template belongs to.",
+				Hydrate:     getDeveloperAppId,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the template.",
+			},
+			{
+				Name:        "object_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of CRM object the template is for, e.g. contacts, companies, tickets or deals.",
+			},
+			{
+				Name:        "header_template",
+				Type:        proto.ColumnType_STRING,
+				Description: "The Markdown and Handlebars template used to render the header of the event on the timeline.",
+			},
+			{
+				Name:        "detail_template",
+				Type:        proto.ColumnType_STRING,
+				Description: "The Markdown and Handlebars template used to render the details of the event on the timeline.",
+			},
+			{
+				Name:        "tokens",
+				Type:        proto.ColumnType_JSON,
+				Description: "The tokens which can be used as custom properties on the events of the template.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the template was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the template was last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listTimelineEventTemplates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	authorizer, appId, err := connectDeveloper(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_timeline_event_template.listTimelineEventTemplates", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := timeline.NewAPIClient(timeline.NewConfiguration())
+
+	// The templates API does not support paging, all the templates are returned in a single response
+	response, _, err := client.TemplatesApi.TemplateGetAll(context, appId).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_timeline_event_template.listTimelineEventTemplates", "api_error", err)
+		return nil, err
+	}
+	for _, template := range response.Results {
+		d.StreamListItem(ctx, template)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getTimelineEventTemplate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	authorizer, appId, err := connectDeveloper(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_timeline_event_template.getTimelineEventTemplate", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := timeline.NewAPIClient(timeline.NewConfiguration())
+
+	template, _, err := client.TemplatesApi.TemplatesGetByID(context, id, appId).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_timeline_event_template.getTimelineEventTemplate", "api_error", err)
+		return nil, err
+	}
+
+	return *template, nil
+}
@@ -0,0 +1,208 @@
+package hubspot
+
+import (
+	"context"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/url_redirects"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotUrlRedirect(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_url_redirect",
+		Description: "List of HubSpot URL Redirects.",
+		List: &plugin.ListConfig{
+			Hydrate: listUrlRedirects,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "archived",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getUrlRedirect,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the URL redirect.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "route_prefix",
+				Type:        proto.ColumnType_STRING,
+				Description: "The target incoming URL, path, or pattern to match for redirection.",
+			},
+			{
+				Name:        "destination",
+				Type:        proto.ColumnType_STRING,
+				Description: "The destination URL, where the target URL is redirected if it matches the route prefix.",
+			},
+			{
+				Name:        "redirect_style",
+				Type:        proto.ColumnType_INT,
+				Description: "The type of the redirect: 301 (permanent), 302 (temporary) or 305 (proxy).",
+			},
+			{
+				Name:        "precedence",
+				Type:        proto.ColumnType_INT,
+				Description: "Used to prioritize URL redirection. If a URL matches more than one redirect, the one with the lower precedence is used.",
+			},
+			{
+				Name:        "is_only_after_not_found",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the redirect only applies if a live page on the URL isn't found.",
+				Transform:   transform.FromField("IsOnlyAfterNotFound"),
+			},
+			{
+				Name:        "is_match_full_url",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the route prefix matches on the entire URL, including the domain.",
+				Transform:   transform.FromField("IsMatchFullUrl"),
+			},
+			{
+				Name:        "is_match_query_string",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the route prefix matches on the entire URL path, including the query string.",
+				Transform:   transform.FromField("IsMatchQueryString"),
+			},
+			{
+				Name:        "is_pattern",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the route prefix matches based on a pattern.",
+				Transform:   transform.FromField("IsPattern"),
+			},
+			{
+				Name:        "is_trailing_slash_optional",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether a trailing slash is ignored.",
+				Transform:   transform.FromField("IsTrailingSlashOptional"),
+			},
+			{
+				Name:        "is_protocol_agnostic",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the route prefix matches both the HTTP and HTTPS protocols.",
+				Transform:   transform.FromField("IsProtocolAgnostic"),
+			},
+			{
+				Name:        "archived",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the URL redirect is archived. Archived redirects are only returned when listing with archived = true.",
+				Transform:   transform.FromField("Archived"),
+			},
+			{
+				Name:        "created",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the URL redirect was created.",
+			},
+			{
+				Name:        "updated",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the URL redirect was last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoutePrefix"),
+			},
+		}),
+	}
+}
+
+// UrlRedirect :: the API returns no deletion timestamp for redirects, so the archived state is the flag the redirect was fetched with
+type UrlRedirect struct {
+	url_redirects.UrlMapping
+	Archived bool
+}
+
+//// LIST FUNCTION
+
+func listUrlRedirects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_url_redirect.listUrlRedirects", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := url_redirects.NewAPIClient(url_redirects.NewConfiguration())
+
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+	var after string = ""
+	archived := false
+
+	if d.EqualsQuals["archived"] != nil {
+		archived = d.EqualsQuals["archived"].GetBoolValue()
+	}
+
+	for {
+		request := client.RedirectsApi.GetPage(context).Limit(maxLimit).Archived(archived)
+		if after != "" {
+			request = request.After(after)
+		}
+		response, _, err := request.Execute()
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_url_redirect.listUrlRedirects", "api_error", err)
+			return nil, err
+		}
+		for _, urlRedirect := range response.Results {
+			d.StreamListItem(ctx, UrlRedirect{UrlMapping: urlRedirect, Archived: archived})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if !response.Paging.HasNext() {
+			break
+		}
+		after = response.Paging.Next.After
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getUrlRedirect(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_url_redirect.getUrlRedirect", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := url_redirects.NewAPIClient(url_redirects.NewConfiguration())
+
+	urlRedirect, _, err := client.RedirectsApi.GetByID(context, id).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_url_redirect.getUrlRedirect", "api_error", err)
+		return nil, err
+	}
+
+	// The redirects are fetched by ID without the archived flag, which only returns the redirects that are not archived
+	return UrlRedirect{UrlMapping: *urlRedirect, Archived: false}, nil
+}
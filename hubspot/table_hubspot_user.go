@@ -0,0 +1,165 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotUser(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_user",
+		Description: "List of HubSpot portal Users.",
+		List: &plugin.ListConfig{
+			Hydrate: listUsers,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getUser,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_INT,
+				Description: "The unique ID of the user.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "email",
+				Type:        proto.ColumnType_STRING,
+				Description: "The email address of the user.",
+			},
+			{
+				Name:        "first_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The first name of the user.",
+			},
+			{
+				Name:        "last_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The last name of the user.",
+			},
+			{
+				Name:        "role_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the permission role assigned to the user.",
+				Transform:   transform.FromField("RoleId"),
+			},
+			{
+				Name:        "role_ids",
+				Type:        proto.ColumnType_JSON,
+				Description: "The IDs of all the roles assigned to the user.",
+				Transform:   transform.FromField("RoleIds"),
+			},
+			{
+				Name:        "primary_team_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the primary team of the user.",
+				Transform:   transform.FromField("PrimaryTeamId"),
+			},
+			{
+				Name:        "secondary_team_ids",
+				Type:        proto.ColumnType_JSON,
+				Description: "The IDs of the secondary teams of the user.",
+				Transform:   transform.FromField("SecondaryTeamIds"),
+			},
+			{
+				Name:        "super_admin",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the user is a super admin or not.",
+				Transform:   transform.FromField("SuperAdmin"),
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Email"),
+			},
+		}),
+	}
+}
+
+type User struct {
+	Id               string   `json:"id"`
+	Email            string   `json:"email"`
+	FirstName        string   `json:"firstName"`
+	LastName         string   `json:"lastName"`
+	RoleId           string   `json:"roleId"`
+	RoleIds          []string `json:"roleIds"`
+	PrimaryTeamId    string   `json:"primaryTeamId"`
+	SecondaryTeamIds []string `json:"secondaryTeamIds"`
+	SuperAdmin       bool     `json:"superAdmin"`
+}
+
+type UsersResponse struct {
+	Results []User         `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listUsers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+
+	for {
+		var response UsersResponse
+		err := getHubSpotApiResponse(ctx, d, "/settings/v3/users", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_user.listUsers", "api_error", err)
+			return nil, err
+		}
+		for _, user := range response.Results {
+			d.StreamListItem(ctx, user)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getUser(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["id"].GetInt64Value()
+
+	// check if id is empty
+	if id == 0 {
+		return nil, nil
+	}
+
+	var user User
+	err := getHubSpotApiResponse(ctx, d, "/settings/v3/users/"+strconv.FormatInt(id, 10), nil, &user)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_user.getUser", "api_error", err)
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,89 @@
+package hubspot
+
+import (
+	"context"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/webhooks"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotWebhookSettings(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_webhook_settings",
+		Description: "Webhook settings of the configured HubSpot app.",
+		List: &plugin.ListConfig{
+			Hydrate: listWebhookSettings,
+		},
+		// App level resources belong to the developer account rather than a portal, so there is no portal_id column
+		Columns: []*plugin.Column{
+			{
+				Name:        "app_id",
+				Type:        proto.ColumnType_INT,
+				Description: "The ID of the app the settings belong to.",
+				Hydrate:     getDeveloperAppId,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "target_url",
+				Type:        proto.ColumnType_STRING,
+				Description: "The URL HubSpot delivers the event payloads to.",
+			},
+			{
+				Name:        "max_concurrent_requests",
+				Type:        proto.ColumnType_INT,
+				Description: "The maximum number of requests HubSpot makes to the app in the throttling period.",
+				Transform:   transform.FromField("Throttling.MaxConcurrentRequests"),
+			},
+			{
+				Name:        "throttling_period",
+				Type:        proto.ColumnType_STRING,
+				Description: "The time scale of the throttling, either SECONDLY or ROLLING_MINUTE.",
+				Transform:   transform.FromField("Throttling.Period"),
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the settings were created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the settings were last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetUrl"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listWebhookSettings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	authorizer, appId, err := connectDeveloper(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_webhook_settings.listWebhookSettings", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := webhooks.NewAPIClient(webhooks.NewConfiguration())
+
+	settings, _, err := client.SettingsApi.SettingsGetAll(context, appId).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_webhook_settings.listWebhookSettings", "api_error", err)
+		return nil, err
+	}
+	d.StreamListItem(ctx, *settings)
+
+	return nil, nil
+}
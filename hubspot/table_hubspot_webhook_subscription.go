@@ -0,0 +1,139 @@
+package hubspot
+
+import (
+	"context"
+	"strconv"
+
+	hubspot "github.com/clarkmcc/go-hubspot"
+	"github.com/clarkmcc/go-hubspot/generated/v3/webhooks"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotWebhookSubscription(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_webhook_subscription",
+		Description: "List of HubSpot webhook subscriptions of the configured app.",
+		List: &plugin.ListConfig{
+			Hydrate: listWebhookSubscriptions,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getWebhookSubscription,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		// App level resources belong to the developer account rather than a portal, so there is no portal_id column
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the subscription.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "app_id",
+				Type:        proto.ColumnType_INT,
+				Description: "The ID of the app the subscription belongs to.",
+				Hydrate:     getDeveloperAppId,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "event_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of event the subscription listens for, e.g. contact.creation or contact.propertyChange.",
+			},
+			{
+				Name:        "property_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The internal name of the property monitored for changes. Only applies to property change events.",
+			},
+			{
+				Name:        "active",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the subscription is active or paused.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the subscription was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the subscription was last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventType"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listWebhookSubscriptions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	authorizer, appId, err := connectDeveloper(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_webhook_subscription.listWebhookSubscriptions", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := webhooks.NewAPIClient(webhooks.NewConfiguration())
+
+	// The subscriptions API does not support paging, all the subscriptions are returned in a single response
+	response, _, err := client.SubscriptionsApi.SubscriptionsGetAll(context, appId).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_webhook_subscription.listWebhookSubscriptions", "api_error", err)
+		return nil, err
+	}
+	for _, subscription := range response.Results {
+		d.StreamListItem(ctx, subscription)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getWebhookSubscription(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Subscription IDs are numeric, other values cannot match any subscription
+	subscriptionId, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return nil, nil
+	}
+
+	authorizer, appId, err := connectDeveloper(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_webhook_subscription.getWebhookSubscription", "connection_error", err)
+		return nil, err
+	}
+	context := hubspot.WithAuthorizer(context.Background(), authorizer)
+	client := webhooks.NewAPIClient(webhooks.NewConfiguration())
+
+	subscription, _, err := client.SubscriptionsApi.SubscriptionsGetByID(context, int32(subscriptionId), appId).Execute()
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_webhook_subscription.getWebhookSubscription", "api_error", err)
+		return nil, err
+	}
+
+	return *subscription, nil
+}
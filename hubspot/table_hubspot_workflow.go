@@ -0,0 +1,226 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableHubSpotWorkflow(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "hubspot_workflow",
+		Description: "List of HubSpot automation Workflows.",
+		List: &plugin.ListConfig{
+			Hydrate: listWorkflows,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getWorkflow,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The unique ID of the workflow.",
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the workflow.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The type of the workflow, e.g. CONTACT_FLOW, PLATFORM_FLOW.",
+			},
+			{
+				Name:        "flow_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "The flow type of the workflow, e.g. WORKFLOW, ACTION_SET.",
+			},
+			{
+				Name:        "object_type_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the object type the workflow enrolls, e.g. 0-1 for contacts.",
+				Transform:   transform.FromField("ObjectTypeId"),
+			},
+			{
+				Name:        "is_enabled",
+				Type:        proto.ColumnType_BOOL,
+				Description: "Indicates whether the workflow is enabled or not.",
+				Transform:   transform.FromField("IsEnabled"),
+			},
+			{
+				Name:        "revision_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The current revision of the workflow.",
+				Transform:   transform.FromField("RevisionId"),
+			},
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "The description of the workflow.",
+				Hydrate:     getWorkflow,
+			},
+			{
+				Name:        "start_action_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "The ID of the first action of the workflow.",
+				Hydrate:     getWorkflow,
+				Transform:   transform.FromField("StartActionId"),
+			},
+			{
+				Name:        "enrollment_criteria",
+				Type:        proto.ColumnType_JSON,
+				Description: "The criteria which decide which records are enrolled in the workflow.",
+				Hydrate:     getWorkflow,
+			},
+			{
+				Name:        "actions",
+				Type:        proto.ColumnType_JSON,
+				Description: "The definitions of the actions performed by the workflow.",
+				Hydrate:     getWorkflow,
+			},
+			{
+				Name:        "goal_filter_branch",
+				Type:        proto.ColumnType_JSON,
+				Description: "The goal criteria of the workflow. Records meeting the goal are unenrolled.",
+				Hydrate:     getWorkflow,
+			},
+			{
+				Name:        "suppression_list_ids",
+				Type:        proto.ColumnType_JSON,
+				Description: "The IDs of the lists whose members are never enrolled in the workflow.",
+				Hydrate:     getWorkflow,
+			},
+			{
+				Name:        "time_windows",
+				Type:        proto.ColumnType_JSON,
+				Description: "The time windows in which the actions of the workflow are allowed to run.",
+				Hydrate:     getWorkflow,
+			},
+			{
+				Name:        "blocked_dates",
+				Type:        proto.ColumnType_JSON,
+				Description: "The dates on which the actions of the workflow are not allowed to run.",
+				Hydrate:     getWorkflow,
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the workflow was created.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "The timestamp when the workflow was last updated.",
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type Workflow struct {
+	Id                 string      `json:"id"`
+	Name               string      `json:"name"`
+	Type               string      `json:"type"`
+	FlowType           string      `json:"flowType"`
+	ObjectTypeId       string      `json:"objectTypeId"`
+	IsEnabled          bool        `json:"isEnabled"`
+	RevisionId         string      `json:"revisionId"`
+	Description        string      `json:"description"`
+	StartActionId      string      `json:"startActionId"`
+	EnrollmentCriteria interface{} `json:"enrollmentCriteria"`
+	Actions            interface{} `json:"actions"`
+	GoalFilterBranch   interface{} `json:"goalFilterBranch"`
+	SuppressionListIds interface{} `json:"suppressionListIds"`
+	TimeWindows        interface{} `json:"timeWindows"`
+	BlockedDates       interface{} `json:"blockedDates"`
+	CreatedAt          *time.Time  `json:"createdAt"`
+	UpdatedAt          *time.Time  `json:"updatedAt"`
+}
+
+type WorkflowsResponse struct {
+	Results []Workflow     `json:"results"`
+	Paging  *hubSpotPaging `json:"paging"`
+}
+
+//// LIST FUNCTION
+
+func listWorkflows(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Limiting the results
+	var maxLimit int32 = 100
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(int(maxLimit)))
+
+	for {
+		var response WorkflowsResponse
+		err := getHubSpotApiResponse(ctx, d, "/automation/v4/flows", params, &response)
+		if err != nil {
+			plugin.Logger(ctx).Error("hubspot_workflow.listWorkflows", "api_error", err)
+			return nil, err
+		}
+		for _, workflow := range response.Results {
+			d.StreamListItem(ctx, workflow)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		after := response.Paging.nextPageCursor()
+		if after == "" {
+			break
+		}
+		params.Set("after", after)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getWorkflow(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = h.Item.(Workflow).Id
+	} else {
+		id = d.EqualsQualString("id")
+	}
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	var workflow Workflow
+	err := getHubSpotApiResponse(ctx, d, "/automation/v4/flows/"+url.PathEscape(id), nil, &workflow)
+	if err != nil {
+		plugin.Logger(ctx).Error("hubspot_workflow.getWorkflow", "api_error", err)
+		return nil, err
+	}
+
+	return workflow, nil
+}
@@ -2,13 +2,22 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"time"
 
 	hubspot "github.com/clarkmcc/go-hubspot"
 	"github.com/clarkmcc/go-hubspot/generated/v3/properties"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
 func connect(ctx context.Context, d *plugin.QueryData) (*hubspot.TokenAuthorizer, error) {
@@ -20,20 +29,43 @@ func connect(ctx context.Context, d *plugin.QueryData) (*hubspot.TokenAuthorizer
 	return conn.(*hubspot.TokenAuthorizer), nil
 }
 
-var connectAppTokenCached = plugin.HydrateFunc(connectAppTokenUncached).Memoize()
+var connectAppTokenCached = plugin.HydrateFunc(connectAppTokenUncached).Memoize(memoize.WithCacheKeyFunction(connectAppTokenCacheKey))
+
+// Build a cache key for the call to connectAppTokenCached, the authorizer of each portal of the connection is cached separately.
+func connectAppTokenCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	key := "connectAppToken-" + getPortalName(ctx)
+	return key, nil
+}
 
 func connectAppTokenUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (any, error) {
+	hubSpotConfig := GetConfig(d.Connection)
+
+	// Connections with multiple portals use the token of the portal the call is made for.
+	// Calls made outside of the portal matrix, e.g. to load the property columns, use the first portal.
+	portals, err := getConfiguredPortals(hubSpotConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(portals) > 0 {
+		portalName := getPortalName(ctx)
+		for _, portal := range portals {
+			if portalName == "" || portal.Name == portalName {
+				return hubspot.NewTokenAuthorizer(portal.Token), nil
+			}
+		}
+		return nil, fmt.Errorf("the portal '%s' is not configured", portalName)
+	}
+
 	// Default to the env var settings
 	appToken := os.Getenv("HUBSPOT_PRIVATE_APP_TOKEN")
 
 	// Prefer config settings
-	hubSpotConfig := GetConfig(d.Connection)
 	if hubSpotConfig.PrivateAppToken != nil {
 		appToken = *hubSpotConfig.PrivateAppToken
 	}
 
 	if appToken == "" {
-		return nil, errors.New("'private_app_token' must be configured")
+		return nil, errors.New("'private_app_token' or 'portals' must be configured")
 	}
 
 	authorizer := hubspot.NewTokenAuthorizer(appToken)
@@ -41,6 +73,43 @@ func connectAppTokenUncached(ctx context.Context, d *plugin.QueryData, _ *plugin
 	return authorizer, nil
 }
 
+// connectDeveloper :: return the developer API key authorizer and the app ID used by the app level APIs, e.g. webhooks
+func connectDeveloper(_ context.Context, d *plugin.QueryData) (*hubspot.APIKeyAuthorizer, int32, error) {
+	// Default to the env var settings
+	apiKey := os.Getenv("HUBSPOT_DEVELOPER_API_KEY")
+	appId := os.Getenv("HUBSPOT_APP_ID")
+
+	// Prefer config settings
+	hubSpotConfig := GetConfig(d.Connection)
+	if hubSpotConfig.DeveloperApiKey != nil {
+		apiKey = *hubSpotConfig.DeveloperApiKey
+	}
+	if hubSpotConfig.AppId != nil {
+		appId = strconv.Itoa(*hubSpotConfig.AppId)
+	}
+
+	if apiKey == "" || appId == "" {
+		return nil, 0, errors.New("'app_id' and 'developer_api_key' must be configured to query the app level tables")
+	}
+
+	id, err := strconv.ParseInt(appId, 10, 32)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid 'app_id' %q: %v", appId, err)
+	}
+
+	return hubspot.NewAPIKeyAuthorizer(apiKey), int32(id), nil
+}
+
+// getDeveloperAppId :: hydrate the ID of the app configured for the connection
+func getDeveloperAppId(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	_, appId, err := connectDeveloper(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return appId, nil
+}
+
 func listAllPropertiesByObjectType(ctx context.Context, d *plugin.QueryData, objectType string) ([]properties.Property, error) {
 	authorizer, err := connect(ctx, d)
 	if err != nil {
@@ -74,3 +143,107 @@ func setDynamicColumnTypes(property properties.Property, column *plugin.Column)
 		column.Type = proto.ColumnType_STRING
 	}
 }
+
+// hubSpotApiBaseURL is the base URL for the HubSpot endpoints which are not covered by the generated API clients.
+const hubSpotApiBaseURL = "https://api.hubapi.com"
+
+// getHubSpotApiResponse :: send a GET request to the given HubSpot API path and unmarshal the JSON response body into result
+// If result is a *string, the raw response body is returned instead
+func getHubSpotApiResponse(ctx context.Context, d *plugin.QueryData, path string, params url.Values, result interface{}) error {
+	authorizer, err := connect(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	endpoint := hubSpotApiBaseURL + path
+	if len(params) > 0 {
+		endpoint = endpoint + "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	// Add authorization header to the request
+	req.Header.Add("Authorization", "Bearer "+authorizer.Token)
+	raw, isRaw := result.(*string)
+	if isRaw {
+		req.Header.Add("Accept", "*/*")
+	} else {
+		req.Header.Add("Accept", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	// Keep the status code in the error message so that the ignore and retry configs can match on it
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s %s: %s", resp.Status, path, string(responseBody))
+	}
+
+	if isRaw {
+		*raw = string(responseBody)
+		return nil
+	}
+
+	return json.Unmarshal(responseBody, result)
+}
+
+// hubSpotPaging :: cursor based paging information returned by the HubSpot v3 list endpoints
+type hubSpotPaging struct {
+	Next *struct {
+		After string `json:"after"`
+		Link  string `json:"link"`
+	} `json:"next"`
+}
+
+// nextPageCursor :: return the cursor of the next page, or an empty string if there are no more pages
+func (p *hubSpotPaging) nextPageCursor() string {
+	if p == nil || p.Next == nil {
+		return ""
+	}
+	return p.Next.After
+}
+
+// getTimeRangeFromQuals :: return the lower and upper bounds set on the given timestamp column by the query quals
+func getTimeRangeFromQuals(d *plugin.QueryData, column string) (start *time.Time, end *time.Time) {
+	if d.Quals[column] == nil {
+		return nil, nil
+	}
+
+	for _, q := range d.Quals[column].Quals {
+		timestamp := q.Value.GetTimestampValue().AsTime()
+		switch q.Operator {
+		case ">", ">=":
+			if start == nil || timestamp.After(*start) {
+				start = &timestamp
+			}
+		case "<", "<=":
+			if end == nil || timestamp.Before(*end) {
+				end = &timestamp
+			}
+		}
+	}
+
+	return start, end
+}
+
+// archivedFromDeletedAt :: CMS objects are archived once they have a deletion timestamp, live objects report either no timestamp or the Unix epoch
+func archivedFromDeletedAt(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch deletedAt := d.Value.(type) {
+	case time.Time:
+		return deletedAt.After(time.Unix(0, 0)), nil
+	case *time.Time:
+		return deletedAt != nil && deletedAt.After(time.Unix(0, 0)), nil
+	}
+	return false, nil
+}